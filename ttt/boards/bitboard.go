@@ -0,0 +1,133 @@
+package boards
+
+import (
+	"fmt"
+	"math/bits"
+)
+
+const fullMask uint16 = 1<<TotalCells - 1
+
+type BitBoard struct {
+	X uint16
+	O uint16
+}
+
+var winMasks = buildWinMasks()
+
+func buildWinMasks() [len(winningLines)]uint16 {
+	var masks [len(winningLines)]uint16
+	for index, line := range winningLines {
+		for _, cell := range line {
+			masks[index] |= positionBit(PositionOf(cell[0], cell[1]))
+		}
+	}
+	return masks
+}
+
+func positionBit(position int) uint16 {
+	return 1 << (position - MinPosition)
+}
+
+func FromBoard(board Board) BitBoard {
+	var bitboard BitBoard
+	for row := range boardSize {
+		for col := range boardSize {
+			position := PositionOf(row, col)
+			switch board[row][col] {
+			case PlayerX:
+				bitboard.X |= positionBit(position)
+			case PlayerO:
+				bitboard.O |= positionBit(position)
+			}
+		}
+	}
+	return bitboard
+}
+
+func (bitboard BitBoard) ToBoard() Board {
+	board := NewBoard()
+	for position := MinPosition; position <= MaxPosition; position++ {
+		row, col := board.getCoordinates(position)
+		switch {
+		case bitboard.X&positionBit(position) != 0:
+			board[row][col] = PlayerX
+		case bitboard.O&positionBit(position) != 0:
+			board[row][col] = PlayerO
+		}
+	}
+	return board
+}
+
+func (bitboard BitBoard) Occupied() uint16 {
+	return bitboard.X | bitboard.O
+}
+
+func (bitboard BitBoard) IsPositionValid(position int) bool {
+	if position < MinPosition || position > MaxPosition {
+		return false
+	}
+	return bitboard.Occupied()&positionBit(position) == 0
+}
+
+func (bitboard BitBoard) AvailableMoves() []int {
+	moves := make([]int, 0, TotalCells)
+	for empty := ^bitboard.Occupied() & fullMask; empty != 0; empty &= empty - 1 {
+		moves = append(moves, bits.TrailingZeros16(empty)+MinPosition)
+	}
+	return moves
+}
+
+// Play places player at position without validation; callers searching the
+// tree only pass positions taken from AvailableMoves.
+func (bitboard BitBoard) Play(position int, player Cell) BitBoard {
+	if player == PlayerX {
+		bitboard.X |= positionBit(position)
+	} else {
+		bitboard.O |= positionBit(position)
+	}
+	return bitboard
+}
+
+func (bitboard BitBoard) MakeMove(position int, player Cell) (BitBoard, error) {
+	if position < MinPosition || position > MaxPosition {
+		return bitboard, fmt.Errorf("position must be between %d and %d", MinPosition, MaxPosition)
+	}
+
+	if !bitboard.IsPositionValid(position) {
+		return bitboard, fmt.Errorf("position already taken")
+	}
+
+	return bitboard.Play(position, player), nil
+}
+
+func hasWinningLine(mask uint16) bool {
+	for _, winMask := range winMasks {
+		if mask&winMask == winMask {
+			return true
+		}
+	}
+	return false
+}
+
+func (bitboard BitBoard) CheckWinner() Cell {
+	if hasWinningLine(bitboard.X) {
+		return PlayerX
+	}
+	if hasWinningLine(bitboard.O) {
+		return PlayerO
+	}
+	return Empty
+}
+
+func (bitboard BitBoard) GetGameStatus() GameStatus {
+	if hasWinningLine(bitboard.X) {
+		return XWins
+	}
+	if hasWinningLine(bitboard.O) {
+		return OWins
+	}
+	if bitboard.Occupied() == fullMask {
+		return Draw
+	}
+	return InProgress
+}
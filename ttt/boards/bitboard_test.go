@@ -0,0 +1,166 @@
+package boards
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestBitBoard_RoundTripsBoard(t *testing.T) {
+	board := Board{
+		{PlayerX, PlayerO, Empty},
+		{Empty, PlayerX, Empty},
+		{PlayerO, Empty, Empty},
+	}
+
+	bitboard := FromBoard(board)
+
+	assertBoardEquals(t, bitboard.ToBoard(), board, "round trip")
+}
+
+func TestBitBoard_EmptyBoardHasAllMoves(t *testing.T) {
+	moves := FromBoard(NewBoard()).AvailableMoves()
+
+	if len(moves) != TotalCells {
+		t.Fatalf("should have %d moves, got %d", TotalCells, len(moves))
+	}
+
+	for index, move := range moves {
+		if move != index+MinPosition {
+			t.Errorf("moves should be in position order, got %v", moves)
+			break
+		}
+	}
+}
+
+func TestBitBoard_MakeMoveRejectsOccupiedAndOutOfRange(t *testing.T) {
+	bitboard, err := BitBoard{}.MakeMove(5, PlayerX)
+	if err != nil {
+		t.Fatalf("position 5 should accept move: %v", err)
+	}
+
+	if _, err := bitboard.MakeMove(5, PlayerO); err == nil {
+		t.Error("should reject occupied position")
+	}
+
+	if _, err := bitboard.MakeMove(10, PlayerO); err == nil {
+		t.Error("should reject position 10")
+	}
+}
+
+func TestBitBoard_MatchesBoardOnEveryWinningLine(t *testing.T) {
+	for _, line := range winningLines {
+		board := NewBoard()
+		for _, cell := range line {
+			board[cell[0]][cell[1]] = PlayerO
+		}
+
+		bitboard := FromBoard(board)
+
+		if bitboard.CheckWinner() != PlayerO {
+			t.Errorf("should detect O win on line %v", line)
+		}
+
+		if bitboard.GetGameStatus() != board.GetGameStatus() {
+			t.Errorf("status mismatch on line %v", line)
+		}
+	}
+}
+
+func TestBitBoard_DetectsDraw(t *testing.T) {
+	board := Board{
+		{PlayerX, PlayerO, PlayerX},
+		{PlayerX, PlayerO, PlayerO},
+		{PlayerO, PlayerX, PlayerX},
+	}
+
+	if FromBoard(board).GetGameStatus() != Draw {
+		t.Error("full board with no winner should be draw")
+	}
+}
+
+func countGamesOnBoard(board Board, player Cell) int {
+	if board.GetGameStatus() != InProgress {
+		return 1
+	}
+
+	next := PlayerO
+	if player == PlayerO {
+		next = PlayerX
+	}
+
+	games := 0
+	for _, move := range board.AvailableMoves() {
+		boardCopy := board
+		boardCopy.MakeMove(move, player)
+		games += countGamesOnBoard(boardCopy, next)
+	}
+	return games
+}
+
+func countGamesOnBitBoard(bitboard BitBoard, player Cell) int {
+	if bitboard.GetGameStatus() != InProgress {
+		return 1
+	}
+
+	next := PlayerO
+	if player == PlayerO {
+		next = PlayerX
+	}
+
+	games := 0
+	for _, move := range bitboard.AvailableMoves() {
+		games += countGamesOnBitBoard(bitboard.Play(move, player), next)
+	}
+	return games
+}
+
+// compareTrees walks every game on both representations in step and
+// returns the number of finished games.
+func compareTrees(t *testing.T, board Board, bitboard BitBoard, player Cell) int {
+	t.Helper()
+
+	if FromBoard(board) != bitboard {
+		t.Fatalf("boards diverged: %v vs %v", board, bitboard.ToBoard())
+	}
+
+	status := board.GetGameStatus()
+	if bitStatus := bitboard.GetGameStatus(); bitStatus != status {
+		t.Fatalf("status %v on board, %v on bitboard for %v", status, bitStatus, board)
+	}
+	if status != InProgress {
+		return 1
+	}
+
+	moves := board.AvailableMoves()
+	if !slices.Equal(moves, bitboard.AvailableMoves()) {
+		t.Fatalf("moves %v on board, %v on bitboard for %v", moves, bitboard.AvailableMoves(), board)
+	}
+
+	games := 0
+	for _, move := range moves {
+		next := board
+		next.MakeMove(move, player)
+		games += compareTrees(t, next, bitboard.Play(move, player), player.Opponent())
+	}
+	return games
+}
+
+func TestBitBoard_FullTreeMatchesBoard(t *testing.T) {
+	const knownGameCount = 255168
+
+	if games := compareTrees(t, NewBoard(), BitBoard{}, PlayerX); games != knownGameCount {
+		t.Errorf("both boards should enumerate %d games, got %d", knownGameCount, games)
+	}
+}
+
+func BenchmarkFullTree_Board(b *testing.B) {
+	for b.Loop() {
+		countGamesOnBoard(NewBoard(), PlayerX)
+	}
+}
+
+func BenchmarkFullTree_BitBoard(b *testing.B) {
+	for b.Loop() {
+		countGamesOnBitBoard(BitBoard{}, PlayerX)
+	}
+}
@@ -4,15 +4,21 @@ import "fmt"
 
 const (
 	boardSize   = 3
+	BoardSize   = boardSize
 	MinPosition = 1
 	MaxPosition = 9
-	PlayerX     = "X"
-	PlayerO     = "O"
-	EmptyCell   = ""
 	TotalCells  = boardSize * boardSize
 )
 
-type Board [boardSize][boardSize]string
+type Cell uint8
+
+const (
+	Empty Cell = iota
+	PlayerX
+	PlayerO
+)
+
+type Board [boardSize][boardSize]Cell
 
 type GameStatus int
 
@@ -34,16 +40,34 @@ var winningLines = [8][3][2]int{
 	{{0, 2}, {1, 1}, {2, 0}},
 }
 
-func NewBoard() Board {
-	var board Board
+func (cell Cell) String() string {
+	switch cell {
+	case PlayerX:
+		return "X"
+	case PlayerO:
+		return "O"
+	default:
+		return ""
+	}
+}
 
-	for row := range boardSize {
-		for col := range boardSize {
-			cell := boardSize*(row) + col + MinPosition // duplicated below
-			board[row][col] = fmt.Sprintf("%d", cell)
-		}
+func (cell Cell) Opponent() Cell {
+	switch cell {
+	case PlayerX:
+		return PlayerO
+	case PlayerO:
+		return PlayerX
+	default:
+		return Empty
 	}
-	return board
+}
+
+func NewBoard() Board {
+	return Board{}
+}
+
+func PositionOf(row int, col int) int {
+	return boardSize*row + col + MinPosition
 }
 
 func (board Board) IsPositionValid(position int) bool {
@@ -51,16 +75,15 @@ func (board Board) IsPositionValid(position int) bool {
 		return false
 	}
 	row, col := board.getCoordinates(position)
-	return board[row][col] != PlayerX && board[row][col] != PlayerO // duplicated in fn below
+	return board[row][col] == Empty
 }
 
 func (board Board) AvailableMoves() []int {
 	var moves []int
 	for row := range boardSize {
 		for col := range boardSize {
-			if board[row][col] != PlayerX && board[row][col] != PlayerO {
-				cell := boardSize*(row) + col + MinPosition // maybe needs its own fn
-				moves = append(moves, cell)
+			if board[row][col] == Empty {
+				moves = append(moves, PositionOf(row, col))
 			}
 		}
 	}
@@ -72,13 +95,13 @@ func (board Board) getCoordinates(position int) (int, int) {
 	return adjustedPosition / boardSize, adjustedPosition % boardSize
 }
 
-func (board *Board) MakeMove(position int, player string) error {
+func (board *Board) MakeMove(position int, player Cell) error {
 	if position < MinPosition || position > MaxPosition {
 		return fmt.Errorf("position must be between %d and %d", MinPosition, MaxPosition)
 	}
 
 	row, col := board.getCoordinates(position)
-	if board[row][col] == PlayerX || board[row][col] == PlayerO {
+	if board[row][col] != Empty {
 		return fmt.Errorf("position already taken")
 	}
 
@@ -86,33 +109,76 @@ func (board *Board) MakeMove(position int, player string) error {
 	return nil
 }
 
-func tokenAtCell(board Board, cell [2]int) string {
+func (board Board) At(position int) Cell {
+	row, col := board.getCoordinates(position)
+	return board[row][col]
+}
+
+// SwapColors turns every X into O and every O into X.
+func (board Board) SwapColors() Board {
+	for row := range board {
+		for col := range board[row] {
+			board[row][col] = board[row][col].Opponent()
+		}
+	}
+	return board
+}
+
+func tokenAtCell(board Board, cell [2]int) Cell {
 	row := cell[0]
 	col := cell[1]
 	return board[row][col]
 }
 
-func (board Board) CheckWinner() string {
+type Line [boardSize]int
+
+func (board Board) lineOwner(line [3][2]int) Cell {
+	first := tokenAtCell(board, line[0])
+	for _, cell := range line[1:] {
+		if tokenAtCell(board, cell) != first {
+			return Empty
+		}
+	}
+	return first
+}
+
+func toLine(line [3][2]int) Line {
+	var positions Line
+	for index, cell := range line {
+		positions[index] = PositionOf(cell[0], cell[1])
+	}
+	return positions
+}
+
+func (board Board) CheckWinner() Cell {
 	for _, line := range winningLines {
-		// firstPosition := board[line[0][0]][line[0][1]]
-		firstPosition := tokenAtCell(board, line[0])
-		secondPosition := board[line[1][0]][line[1][1]]
-		thirdPosition := board[line[2][0]][line[2][1]]
-
-		// What am I looking at?
-		// This could be a function returning a boolean
-		if firstPosition == secondPosition &&
-			secondPosition == thirdPosition &&
-			(firstPosition == PlayerX || firstPosition == PlayerO) {
-			return firstPosition
+		if owner := board.lineOwner(line); owner != Empty {
+			return owner
 		}
 	}
 
-	return EmptyCell
+	return Empty
+}
+
+// WinningLines returns the winner with every line they completed; a single
+// move can finish two lines at once.
+func (board Board) WinningLines() (Cell, []Line) {
+	winner := board.CheckWinner()
+	if winner == Empty {
+		return Empty, nil
+	}
+
+	var lines []Line
+	for _, line := range winningLines {
+		if board.lineOwner(line) == winner {
+			lines = append(lines, toLine(line))
+		}
+	}
+	return winner, lines
 }
 
 func (board Board) GetGameStatus() GameStatus {
-	if winner := board.CheckWinner(); winner != EmptyCell {
+	if winner := board.CheckWinner(); winner != Empty {
 		if winner == PlayerX {
 			return XWins
 		}
@@ -20,9 +20,9 @@ func TestBoard_InitialBoard(t *testing.T) {
 	board := NewBoard()
 
 	expected := Board{
-		{"1", "2", "3"},
-		{"4", "5", "6"},
-		{"7", "8", "9"},
+		{Empty, Empty, Empty},
+		{Empty, Empty, Empty},
+		{Empty, Empty, Empty},
 	}
 
 	assertBoardEquals(t, board, expected, "new board")
@@ -31,13 +31,13 @@ func TestBoard_InitialBoard(t *testing.T) {
 func TestBoard_MakeMove(t *testing.T) {
 	board := NewBoard()
 
-	err := board.MakeMove(1, "X")
+	err := board.MakeMove(1, PlayerX)
 
 	if err != nil {
 		t.Fatalf("position 1 should accept move: %v", err)
 	}
 
-	if board[0][0] != "X" {
+	if board[0][0] != PlayerX {
 		t.Errorf("expected X at [0][0], got %s", board[0][0])
 	}
 }
@@ -45,13 +45,13 @@ func TestBoard_MakeMove(t *testing.T) {
 func TestBoard_MoveToCenter(t *testing.T) {
 	board := NewBoard()
 
-	err := board.MakeMove(5, "O")
+	err := board.MakeMove(5, PlayerO)
 
 	if err != nil {
 		t.Fatalf("position 5 should accept move: %v", err)
 	}
 
-	if board[1][1] != "O" {
+	if board[1][1] != PlayerO {
 		t.Errorf("expected O at [1][1], got %s", board[1][1])
 	}
 }
@@ -59,13 +59,13 @@ func TestBoard_MoveToCenter(t *testing.T) {
 func TestBoard_MoveToBottomRight(t *testing.T) {
 	board := NewBoard()
 
-	err := board.MakeMove(9, "X")
+	err := board.MakeMove(9, PlayerX)
 
 	if err != nil {
 		t.Fatalf("position 9 should accept move: %v", err)
 	}
 
-	if board[2][2] != "X" {
+	if board[2][2] != PlayerX {
 		t.Errorf("expected X at [2][2], got %s", board[2][2])
 	}
 }
@@ -73,15 +73,15 @@ func TestBoard_MoveToBottomRight(t *testing.T) {
 func TestBoard_CompleteGameSequence(t *testing.T) {
 	board := NewBoard()
 
-	board.MakeMove(1, "X")
-	board.MakeMove(2, "O")
-	board.MakeMove(5, "X")
-	board.MakeMove(9, "O")
+	board.MakeMove(1, PlayerX)
+	board.MakeMove(2, PlayerO)
+	board.MakeMove(5, PlayerX)
+	board.MakeMove(9, PlayerO)
 
 	expected := Board{
-		{"X", "O", "3"},
-		{"4", "X", "6"},
-		{"7", "8", "O"},
+		{PlayerX, PlayerO, Empty},
+		{Empty, PlayerX, Empty},
+		{Empty, Empty, PlayerO},
 	}
 
 	assertBoardEquals(t, board, expected, "after move sequence")
@@ -90,7 +90,7 @@ func TestBoard_CompleteGameSequence(t *testing.T) {
 func TestBoard_Position0(t *testing.T) {
 	board := NewBoard()
 
-	err := board.MakeMove(0, "X")
+	err := board.MakeMove(0, PlayerX)
 
 	if err == nil {
 		t.Error("should return error for position 0")
@@ -100,7 +100,7 @@ func TestBoard_Position0(t *testing.T) {
 func TestBoard_Position10(t *testing.T) {
 	board := NewBoard()
 
-	err := board.MakeMove(10, "X")
+	err := board.MakeMove(10, PlayerX)
 
 	if err == nil {
 		t.Error("should return error for position 10")
@@ -110,7 +110,7 @@ func TestBoard_Position10(t *testing.T) {
 func TestBoard_NegativePosition(t *testing.T) {
 	board := NewBoard()
 
-	err := board.MakeMove(-1, "X")
+	err := board.MakeMove(-1, PlayerX)
 
 	if err == nil {
 		t.Error("should return error for negative position")
@@ -119,9 +119,9 @@ func TestBoard_NegativePosition(t *testing.T) {
 
 func TestBoard_OccupiedByX(t *testing.T) {
 	board := NewBoard()
-	board.MakeMove(5, "X")
+	board.MakeMove(5, PlayerX)
 
-	err := board.MakeMove(5, "O")
+	err := board.MakeMove(5, PlayerO)
 
 	if err == nil {
 		t.Error("should return error for position occupied by X")
@@ -130,9 +130,9 @@ func TestBoard_OccupiedByX(t *testing.T) {
 
 func TestBoard_OccupiedByO(t *testing.T) {
 	board := NewBoard()
-	board.MakeMove(3, "O")
+	board.MakeMove(3, PlayerO)
 
-	err := board.MakeMove(3, "X")
+	err := board.MakeMove(3, PlayerX)
 
 	if err == nil {
 		t.Error("should return error for position occupied by O")
@@ -144,133 +144,133 @@ func TestBoard_NoWinnerOnEmptyBoard(t *testing.T) {
 
 	winner := board.CheckWinner()
 
-	if winner != "" {
+	if winner != Empty {
 		t.Errorf("empty board should have no winner, got %q", winner)
 	}
 }
 
 func TestBoard_XWinTopRow(t *testing.T) {
 	board := Board{
-		{"X", "X", "X"},
-		{"4", "5", "6"},
-		{"7", "8", "9"},
+		{PlayerX, PlayerX, PlayerX},
+		{Empty, Empty, Empty},
+		{Empty, Empty, Empty},
 	}
 
 	winner := board.CheckWinner()
 
-	if winner != "X" {
+	if winner != PlayerX {
 		t.Errorf("should be X winner on top row, got %q", winner)
 	}
 }
 
 func TestBoard_OWinMiddleRow(t *testing.T) {
 	board := Board{
-		{"1", "2", "3"},
-		{"O", "O", "O"},
-		{"7", "8", "9"},
+		{Empty, Empty, Empty},
+		{PlayerO, PlayerO, PlayerO},
+		{Empty, Empty, Empty},
 	}
 
 	winner := board.CheckWinner()
 
-	if winner != "O" {
+	if winner != PlayerO {
 		t.Errorf("should be O winner on middle row, got %q", winner)
 	}
 }
 
 func TestBoard_OWinBottomRow(t *testing.T) {
 	board := Board{
-		{"1", "2", "3"},
-		{"4", "5", "6"},
-		{"O", "O", "O"},
+		{Empty, Empty, Empty},
+		{Empty, Empty, Empty},
+		{PlayerO, PlayerO, PlayerO},
 	}
 
 	winner := board.CheckWinner()
 
-	if winner != "O" {
+	if winner != PlayerO {
 		t.Errorf("should be O winner on bottom row, got %q", winner)
 	}
 }
 
 func TestBoard_OWinLeftColumn(t *testing.T) {
 	board := Board{
-		{"O", "2", "3"},
-		{"O", "5", "6"},
-		{"O", "8", "9"},
+		{PlayerO, Empty, Empty},
+		{PlayerO, Empty, Empty},
+		{PlayerO, Empty, Empty},
 	}
 
 	winner := board.CheckWinner()
 
-	if winner != "O" {
+	if winner != PlayerO {
 		t.Errorf("should be O winner on left column, got %q", winner)
 	}
 }
 
 func TestBoard_XWinMiddleColumn(t *testing.T) {
 	board := Board{
-		{"1", "X", "3"},
-		{"4", "X", "6"},
-		{"7", "X", "9"},
+		{Empty, PlayerX, Empty},
+		{Empty, PlayerX, Empty},
+		{Empty, PlayerX, Empty},
 	}
 
 	winner := board.CheckWinner()
 
-	if winner != "X" {
+	if winner != PlayerX {
 		t.Errorf("should be X winner on middle column, got %q", winner)
 	}
 }
 
 func TestBoard_XWinRightColumn(t *testing.T) {
 	board := Board{
-		{"1", "2", "X"},
-		{"4", "5", "X"},
-		{"7", "8", "X"},
+		{Empty, Empty, PlayerX},
+		{Empty, Empty, PlayerX},
+		{Empty, Empty, PlayerX},
 	}
 
 	winner := board.CheckWinner()
 
-	if winner != "X" {
+	if winner != PlayerX {
 		t.Errorf("should be X winner on right column, got %q", winner)
 	}
 }
 
 func TestBoard_XWinMainDiagonal(t *testing.T) {
 	board := Board{
-		{"X", "2", "3"},
-		{"4", "X", "6"},
-		{"7", "8", "X"},
+		{PlayerX, Empty, Empty},
+		{Empty, PlayerX, Empty},
+		{Empty, Empty, PlayerX},
 	}
 
 	winner := board.CheckWinner()
 
-	if winner != "X" {
+	if winner != PlayerX {
 		t.Errorf("should be X winner on down right diagonal, got %q", winner)
 	}
 }
 
 func TestBoard_OWinAntiDiagonal(t *testing.T) {
 	board := Board{
-		{"1", "2", "O"},
-		{"4", "O", "6"},
-		{"O", "8", "9"},
+		{Empty, Empty, PlayerO},
+		{Empty, PlayerO, Empty},
+		{PlayerO, Empty, Empty},
 	}
 
 	winner := board.CheckWinner()
 
-	if winner != "O" {
+	if winner != PlayerO {
 		t.Errorf("should be O winner on down left diagonal, got %q", winner)
 	}
 }
 
 func TestBoard_NoWinner(t *testing.T) {
 	board := Board{
-		{"X", "O", "3"},
-		{"4", "X", "6"},
-		{"O", "8", "9"},
+		{PlayerX, PlayerO, Empty},
+		{Empty, PlayerX, Empty},
+		{PlayerO, Empty, Empty},
 	}
 
 	winner := board.CheckWinner()
 
-	if winner != "" {
+	if winner != Empty {
 		t.Errorf("partial board should have no winner, got %q", winner)
 	}
 }
@@ -287,9 +287,9 @@ func TestBoard_InProgress(t *testing.T) {
 
 func TestBoard_XWins(t *testing.T) {
 	board := Board{
-		{"X", "X", "X"},
-		{"O", "O", "6"},
-		{"7", "8", "9"},
+		{PlayerX, PlayerX, PlayerX},
+		{PlayerO, PlayerO, Empty},
+		{Empty, Empty, Empty},
 	}
 
 	status := board.GetGameStatus()
@@ -301,9 +301,9 @@ func TestBoard_XWins(t *testing.T) {
 
 func TestBoard_OWins(t *testing.T) {
 	board := Board{
-		{"O", "O", "O"},
-		{"X", "X", "6"},
-		{"7", "8", "9"},
+		{PlayerO, PlayerO, PlayerO},
+		{PlayerX, PlayerX, Empty},
+		{Empty, Empty, Empty},
 	}
 
 	status := board.GetGameStatus()
@@ -315,9 +315,9 @@ func TestBoard_OWins(t *testing.T) {
 
 func TestBoard_DrawOnFullBoard(t *testing.T) {
 	board := Board{
-		{"X", "O", "X"},
-		{"X", "O", "O"},
-		{"O", "X", "X"},
+		{PlayerX, PlayerO, PlayerX},
+		{PlayerX, PlayerO, PlayerO},
+		{PlayerO, PlayerX, PlayerX},
 	}
 
 	status := board.GetGameStatus()
@@ -329,29 +329,29 @@ func TestBoard_DrawOnFullBoard(t *testing.T) {
 
 func TestBoard_FullBoardMove(t *testing.T) {
 	board := Board{
-		{"X", "O", "X"},
-		{"O", "X", "O"},
-		{"X", "O", "9"},
+		{PlayerX, PlayerO, PlayerX},
+		{PlayerO, PlayerX, PlayerO},
+		{PlayerX, PlayerO, Empty},
 	}
 
-	err := board.MakeMove(9, "X")
+	err := board.MakeMove(9, PlayerX)
 
 	if err != nil {
 		t.Errorf("should accept move to last position: %v", err)
 	}
 
-	if board[2][2] != "X" {
+	if board[2][2] != PlayerX {
 		t.Errorf("position 9 should have X, got %s", board[2][2])
 	}
 }
 
 func TestBoard_NoMovesAvailableAfterLastMove(t *testing.T) {
 	board := Board{
-		{"X", "O", "X"},
-		{"O", "X", "O"},
-		{"X", "O", "9"},
+		{PlayerX, PlayerO, PlayerX},
+		{PlayerO, PlayerX, PlayerO},
+		{PlayerX, PlayerO, Empty},
 	}
-	board.MakeMove(9, "X")
+	board.MakeMove(9, PlayerX)
 
 	moves := board.AvailableMoves()
 
@@ -359,3 +359,76 @@ func TestBoard_NoMovesAvailableAfterLastMove(t *testing.T) {
 		t.Errorf("should have no available moves on full board, got %d", len(moves))
 	}
 }
+
+func TestCell_String(t *testing.T) {
+	if PlayerX.String() != "X" || PlayerO.String() != "O" || Empty.String() != "" {
+		t.Errorf("unexpected cell strings: %q %q %q", PlayerX, PlayerO, Empty)
+	}
+}
+
+func TestCell_Opponent(t *testing.T) {
+	if PlayerX.Opponent() != PlayerO || PlayerO.Opponent() != PlayerX {
+		t.Error("X and O should be opponents")
+	}
+
+	if Empty.Opponent() != Empty {
+		t.Error("empty cell should have no opponent")
+	}
+}
+
+func TestBoard_AtReadsPosition(t *testing.T) {
+	board := NewBoard()
+	board.MakeMove(6, PlayerO)
+
+	if board.At(6) != PlayerO {
+		t.Errorf("position 6 should hold O, got %q", board.At(6))
+	}
+
+	if board.At(1) != Empty {
+		t.Errorf("position 1 should be empty, got %q", board.At(1))
+	}
+}
+
+func TestBoard_WinningLinesNoWinner(t *testing.T) {
+	winner, lines := NewBoard().WinningLines()
+
+	if winner != Empty || lines != nil {
+		t.Errorf("empty board should have no winning lines, got %v %v", winner, lines)
+	}
+}
+
+func TestBoard_WinningLinesReportsPositions(t *testing.T) {
+	board := Board{
+		{PlayerO, PlayerX, PlayerX},
+		{Empty, PlayerO, PlayerX},
+		{Empty, Empty, PlayerO},
+	}
+
+	winner, lines := board.WinningLines()
+
+	if winner != PlayerO {
+		t.Fatalf("O should win, got %v", winner)
+	}
+
+	if len(lines) != 1 || lines[0] != (Line{1, 5, 9}) {
+		t.Errorf("should report the 1-5-9 diagonal, got %v", lines)
+	}
+}
+
+func TestBoard_WinningLinesReportsDoubleWin(t *testing.T) {
+	board := Board{
+		{PlayerX, PlayerX, PlayerX},
+		{PlayerO, PlayerX, PlayerO},
+		{PlayerO, PlayerX, PlayerO},
+	}
+
+	winner, lines := board.WinningLines()
+
+	if winner != PlayerX {
+		t.Fatalf("X should win, got %v", winner)
+	}
+
+	if len(lines) != 2 || lines[0] != (Line{1, 2, 3}) || lines[1] != (Line{2, 5, 8}) {
+		t.Errorf("should report the top row and middle column, got %v", lines)
+	}
+}
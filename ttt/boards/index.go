@@ -0,0 +1,71 @@
+package boards
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// Each cell is a base-3 digit (Empty=0, X=1, O=2) with position 1 as the
+// least significant digit, so every 3x3 board maps to a unique index below
+// IndexCount.
+const (
+	cellStates = 3
+	IndexCount = 19683
+	IndexBytes = 2
+)
+
+type Index uint16
+
+func (board Board) Index() Index {
+	var index Index
+	for position := MaxPosition; position >= MinPosition; position-- {
+		index = index*cellStates + Index(board.At(position))
+	}
+	return index
+}
+
+func (bitboard BitBoard) Index() Index {
+	var index Index
+	for position := MaxPosition; position >= MinPosition; position-- {
+		index *= cellStates
+		switch {
+		case bitboard.X&positionBit(position) != 0:
+			index += Index(PlayerX)
+		case bitboard.O&positionBit(position) != 0:
+			index += Index(PlayerO)
+		}
+	}
+	return index
+}
+
+func FromIndex(index Index) (Board, error) {
+	if index >= IndexCount {
+		return Board{}, fmt.Errorf("index must be below %d", IndexCount)
+	}
+
+	var board Board
+	for position := MinPosition; position <= MaxPosition; position++ {
+		row, col := board.getCoordinates(position)
+		board[row][col] = Cell(index % cellStates)
+		index /= cellStates
+	}
+	return board, nil
+}
+
+func (board Board) MarshalBinary() ([]byte, error) {
+	return binary.BigEndian.AppendUint16(nil, uint16(board.Index())), nil
+}
+
+func (board *Board) UnmarshalBinary(data []byte) error {
+	if len(data) != IndexBytes {
+		return fmt.Errorf("board encoding must be %d bytes, got %d", IndexBytes, len(data))
+	}
+
+	decoded, err := FromIndex(Index(binary.BigEndian.Uint16(data)))
+	if err != nil {
+		return err
+	}
+
+	*board = decoded
+	return nil
+}
@@ -0,0 +1,88 @@
+package boards
+
+import (
+	"testing"
+)
+
+func TestIndex_EmptyBoardIsZero(t *testing.T) {
+	if index := NewBoard().Index(); index != 0 {
+		t.Errorf("empty board should have index 0, got %d", index)
+	}
+}
+
+func TestIndex_FirstPositionIsLeastSignificant(t *testing.T) {
+	board := NewBoard()
+	board.MakeMove(1, PlayerO)
+	board.MakeMove(2, PlayerX)
+
+	if index := board.Index(); index != 2+1*3 {
+		t.Errorf("expected index 5, got %d", index)
+	}
+}
+
+func TestIndex_RoundTripsEveryIndex(t *testing.T) {
+	seen := make(map[Board]bool, IndexCount)
+
+	for index := Index(0); index < IndexCount; index++ {
+		board, err := FromIndex(index)
+		if err != nil {
+			t.Fatalf("index %d should decode: %v", index, err)
+		}
+
+		if board.Index() != index {
+			t.Fatalf("index %d decoded to board with index %d", index, board.Index())
+		}
+
+		if FromBoard(board).Index() != index {
+			t.Fatalf("bitboard index mismatch for %d", index)
+		}
+
+		seen[board] = true
+	}
+
+	if len(seen) != IndexCount {
+		t.Errorf("indices should decode to %d distinct boards, got %d", IndexCount, len(seen))
+	}
+}
+
+func TestIndex_RejectsOutOfRange(t *testing.T) {
+	if _, err := FromIndex(IndexCount); err == nil {
+		t.Error("should reject index equal to IndexCount")
+	}
+}
+
+func TestIndex_BinaryRoundTrip(t *testing.T) {
+	board := Board{
+		{PlayerX, PlayerO, Empty},
+		{Empty, PlayerX, Empty},
+		{PlayerO, Empty, PlayerX},
+	}
+
+	data, err := board.MarshalBinary()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(data) != IndexBytes {
+		t.Errorf("encoding should be %d bytes, got %d", IndexBytes, len(data))
+	}
+
+	var decoded Board
+	if err := decoded.UnmarshalBinary(data); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	assertBoardEquals(t, decoded, board, "binary round trip")
+}
+
+func TestIndex_UnmarshalRejectsBadInput(t *testing.T) {
+	var board Board
+
+	if err := board.UnmarshalBinary([]byte{1}); err == nil {
+		t.Error("should reject short input")
+	}
+
+	if err := board.UnmarshalBinary([]byte{0xff, 0xff}); err == nil {
+		t.Error("should reject out-of-range index")
+	}
+}
@@ -0,0 +1,118 @@
+package boards
+
+type Transform int
+
+const (
+	Identity Transform = iota
+	Rotate90
+	Rotate180
+	Rotate270
+	ReflectHorizontal
+	ReflectVertical
+	ReflectDiagonal
+	ReflectAntiDiagonal
+)
+
+var Transforms = [...]Transform{
+	Identity,
+	Rotate90,
+	Rotate180,
+	Rotate270,
+	ReflectHorizontal,
+	ReflectVertical,
+	ReflectDiagonal,
+	ReflectAntiDiagonal,
+}
+
+func (transform Transform) String() string {
+	switch transform {
+	case Identity:
+		return "identity"
+	case Rotate90:
+		return "rotate 90"
+	case Rotate180:
+		return "rotate 180"
+	case Rotate270:
+		return "rotate 270"
+	case ReflectHorizontal:
+		return "reflect horizontal"
+	case ReflectVertical:
+		return "reflect vertical"
+	case ReflectDiagonal:
+		return "reflect diagonal"
+	case ReflectAntiDiagonal:
+		return "reflect anti-diagonal"
+	default:
+		return "unknown"
+	}
+}
+
+// Rotations are clockwise. ReflectHorizontal swaps the top and bottom rows,
+// ReflectVertical swaps the left and right columns, and the diagonal
+// reflections mirror across the 1-5-9 and 3-5-7 diagonals.
+func (transform Transform) mapCoordinates(row int, col int) (int, int) {
+	last := boardSize - 1
+
+	switch transform {
+	case Rotate90:
+		return col, last - row
+	case Rotate180:
+		return last - row, last - col
+	case Rotate270:
+		return last - col, row
+	case ReflectHorizontal:
+		return last - row, col
+	case ReflectVertical:
+		return row, last - col
+	case ReflectDiagonal:
+		return col, row
+	case ReflectAntiDiagonal:
+		return last - col, last - row
+	default:
+		return row, col
+	}
+}
+
+func (transform Transform) Inverse() Transform {
+	switch transform {
+	case Rotate90:
+		return Rotate270
+	case Rotate270:
+		return Rotate90
+	default:
+		return transform
+	}
+}
+
+func (transform Transform) MapPosition(position int) int {
+	row, col := Board{}.getCoordinates(position)
+	return PositionOf(transform.mapCoordinates(row, col))
+}
+
+func (board Board) Transform(transform Transform) Board {
+	var transformed Board
+	for row := range boardSize {
+		for col := range boardSize {
+			newRow, newCol := transform.mapCoordinates(row, col)
+			transformed[newRow][newCol] = board[row][col]
+		}
+	}
+	return transformed
+}
+
+// Canonical returns the symmetric image of the board with the smallest Index
+// and the transform that produces it from this board.
+func (board Board) Canonical() (Board, Transform) {
+	canonical := board
+	used := Identity
+
+	for _, transform := range Transforms[1:] {
+		candidate := board.Transform(transform)
+		if candidate.Index() < canonical.Index() {
+			canonical = candidate
+			used = transform
+		}
+	}
+
+	return canonical, used
+}
@@ -0,0 +1,128 @@
+package boards
+
+import (
+	"testing"
+)
+
+var asymmetricBoard = Board{
+	{PlayerX, PlayerO, Empty},
+	{Empty, Empty, Empty},
+	{Empty, Empty, Empty},
+}
+
+func TestTransform_Rotate90(t *testing.T) {
+	expected := Board{
+		{Empty, Empty, PlayerX},
+		{Empty, Empty, PlayerO},
+		{Empty, Empty, Empty},
+	}
+
+	assertBoardEquals(t, asymmetricBoard.Transform(Rotate90), expected, "rotate 90")
+}
+
+func TestTransform_ReflectVertical(t *testing.T) {
+	expected := Board{
+		{Empty, PlayerO, PlayerX},
+		{Empty, Empty, Empty},
+		{Empty, Empty, Empty},
+	}
+
+	assertBoardEquals(t, asymmetricBoard.Transform(ReflectVertical), expected, "reflect vertical")
+}
+
+func TestTransform_ReflectHorizontal(t *testing.T) {
+	expected := Board{
+		{Empty, Empty, Empty},
+		{Empty, Empty, Empty},
+		{PlayerX, PlayerO, Empty},
+	}
+
+	assertBoardEquals(t, asymmetricBoard.Transform(ReflectHorizontal), expected, "reflect horizontal")
+}
+
+func TestTransform_ReflectDiagonal(t *testing.T) {
+	expected := Board{
+		{PlayerX, Empty, Empty},
+		{PlayerO, Empty, Empty},
+		{Empty, Empty, Empty},
+	}
+
+	assertBoardEquals(t, asymmetricBoard.Transform(ReflectDiagonal), expected, "reflect diagonal")
+}
+
+func TestTransform_RotationsCompose(t *testing.T) {
+	twice := asymmetricBoard.Transform(Rotate90).Transform(Rotate90)
+	assertBoardEquals(t, twice, asymmetricBoard.Transform(Rotate180), "rotate 90 twice")
+
+	thrice := twice.Transform(Rotate90)
+	assertBoardEquals(t, thrice, asymmetricBoard.Transform(Rotate270), "rotate 90 three times")
+}
+
+func TestTransform_InverseRestoresBoard(t *testing.T) {
+	for _, transform := range Transforms {
+		restored := asymmetricBoard.Transform(transform).Transform(transform.Inverse())
+		assertBoardEquals(t, restored, asymmetricBoard, transform.String())
+	}
+}
+
+func TestTransform_AllImagesDistinct(t *testing.T) {
+	seen := map[Board]bool{}
+	for _, transform := range Transforms {
+		seen[asymmetricBoard.Transform(transform)] = true
+	}
+
+	if len(seen) != len(Transforms) {
+		t.Errorf("asymmetric board should have %d images, got %d", len(Transforms), len(seen))
+	}
+}
+
+func TestTransform_MapPositionMatchesBoard(t *testing.T) {
+	for _, transform := range Transforms {
+		for position := MinPosition; position <= MaxPosition; position++ {
+			board := NewBoard()
+			board.MakeMove(position, PlayerX)
+
+			mapped := transform.MapPosition(position)
+			if board.Transform(transform).At(mapped) != PlayerX {
+				t.Errorf("%s: position %d should map to %d", transform, position, mapped)
+			}
+		}
+	}
+}
+
+func TestTransform_CenterIsFixed(t *testing.T) {
+	for _, transform := range Transforms {
+		if transform.MapPosition(5) != 5 {
+			t.Errorf("%s should keep the center fixed", transform)
+		}
+	}
+}
+
+func TestCanonical_SameForAllImages(t *testing.T) {
+	canonical, _ := asymmetricBoard.Canonical()
+
+	for _, transform := range Transforms {
+		image, used := asymmetricBoard.Transform(transform).Canonical()
+		if image != canonical {
+			t.Errorf("%s image should share the canonical form", transform)
+		}
+
+		if asymmetricBoard.Transform(transform).Transform(used) != image {
+			t.Errorf("%s: returned transform should produce the canonical board", transform)
+		}
+	}
+}
+
+func TestCanonical_CountsDistinctOpenings(t *testing.T) {
+	seen := map[Board]bool{}
+	for _, move := range NewBoard().AvailableMoves() {
+		board := NewBoard()
+		board.MakeMove(move, PlayerX)
+		canonical, _ := board.Canonical()
+		seen[canonical] = true
+	}
+
+	if len(seen) != 3 {
+		t.Errorf("should have 3 distinct openings (corner, edge, center), got %d", len(seen))
+	}
+}
@@ -0,0 +1,89 @@
+package boards
+
+import "slices"
+
+// threatCell returns the empty cell of a line holding two of player's marks
+// and nothing else, or 0 when the line is not a threat.
+func (board Board) threatCell(line [3][2]int, player Cell) int {
+	own, open := 0, 0
+	for _, cell := range line {
+		switch tokenAtCell(board, cell) {
+		case player:
+			own++
+		case Empty:
+			open = PositionOf(cell[0], cell[1])
+		}
+	}
+
+	if own == boardSize-1 && open != 0 {
+		return open
+	}
+	return 0
+}
+
+// WinningMoves lists the positions, in ascending order, where player
+// completes a line on this move.
+func (board Board) WinningMoves(player Cell) []int {
+	var moves []int
+	for _, line := range winningLines {
+		if position := board.threatCell(line, player); position != 0 && !slices.Contains(moves, position) {
+			moves = append(moves, position)
+		}
+	}
+	slices.Sort(moves)
+	return moves
+}
+
+// BlockingMoves lists the positions player must take to stop the opponent
+// winning on their next move.
+func (board Board) BlockingMoves(player Cell) []int {
+	return board.WinningMoves(player.Opponent())
+}
+
+// ForkMoves lists the positions that leave player with two or more winning
+// moves at once, so the opponent cannot block them all.
+func (board Board) ForkMoves(player Cell) []int {
+	var moves []int
+	for _, position := range board.AvailableMoves() {
+		next := board
+		next.MakeMove(position, player)
+		if len(next.WinningMoves(player)) >= 2 {
+			moves = append(moves, position)
+		}
+	}
+	return moves
+}
+
+// ForkBlockingMoves lists the positions that stop the opponent forking next
+// turn: afterwards either no fork is left, or player threatens a win that
+// the opponent must block on a cell that is not a fork for them. It is empty
+// when the opponent has no fork to play.
+func (board Board) ForkBlockingMoves(player Cell) []int {
+	opponent := player.Opponent()
+	if len(board.ForkMoves(opponent)) == 0 {
+		return nil
+	}
+
+	var moves []int
+	for _, position := range board.AvailableMoves() {
+		next := board
+		next.MakeMove(position, player)
+
+		if next.CheckWinner() == player {
+			moves = append(moves, position)
+			continue
+		}
+
+		forks := next.ForkMoves(opponent)
+		threats := next.WinningMoves(player)
+		switch {
+		case len(forks) == 0:
+			moves = append(moves, position)
+		case len(threats) >= 2:
+			moves = append(moves, position)
+		case len(threats) == 1 && !slices.Contains(forks, threats[0]):
+			moves = append(moves, position)
+		}
+	}
+	return moves
+}
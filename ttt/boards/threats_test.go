@@ -0,0 +1,96 @@
+package boards
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestBoard_WinningAndBlockingMoves(t *testing.T) {
+	board := Board{
+		{PlayerX, PlayerX, Empty},
+		{PlayerO, PlayerO, Empty},
+		{Empty, Empty, Empty},
+	}
+
+	if moves := board.WinningMoves(PlayerX); !slices.Equal(moves, []int{3}) {
+		t.Errorf("X winning moves: expected [3], got %v", moves)
+	}
+
+	if moves := board.BlockingMoves(PlayerX); !slices.Equal(moves, []int{6}) {
+		t.Errorf("X blocking moves: expected [6], got %v", moves)
+	}
+}
+
+func TestBoard_WinningMoves_ListsSharedCellOnce(t *testing.T) {
+	board := Board{
+		{PlayerX, Empty, PlayerX},
+		{Empty, Empty, Empty},
+		{PlayerX, Empty, PlayerX},
+	}
+
+	if moves := board.WinningMoves(PlayerX); !slices.Equal(moves, []int{2, 4, 5, 6, 8}) {
+		t.Errorf("expected [2 4 5 6 8], got %v", moves)
+	}
+}
+
+func TestBoard_ForkMoves(t *testing.T) {
+	board := Board{
+		{PlayerX, Empty, Empty},
+		{Empty, PlayerO, Empty},
+		{Empty, Empty, PlayerX},
+	}
+
+	if moves := board.ForkMoves(PlayerX); !slices.Equal(moves, []int{3, 7}) {
+		t.Errorf("X fork moves: expected [3 7], got %v", moves)
+	}
+
+	if moves := board.ForkMoves(PlayerO); len(moves) != 0 {
+		t.Errorf("O should have no forks, got %v", moves)
+	}
+}
+
+func TestBoard_ForkBlockingMoves_ForcesDefenceAwayFromForks(t *testing.T) {
+	// Taking a fork corner lets X answer with the other fork; only an edge
+	// threat makes X block on a harmless square.
+	board := Board{
+		{PlayerX, Empty, Empty},
+		{Empty, PlayerO, Empty},
+		{Empty, Empty, PlayerX},
+	}
+
+	if moves := board.ForkBlockingMoves(PlayerO); !slices.Equal(moves, []int{2, 4, 6, 8}) {
+		t.Errorf("expected [2 4 6 8], got %v", moves)
+	}
+}
+
+func TestBoard_ForkBlockingMoves_EmptyWithoutOpponentFork(t *testing.T) {
+	if moves := NewBoard().ForkBlockingMoves(PlayerO); moves != nil {
+		t.Errorf("expected no fork blocks on an empty board, got %v", moves)
+	}
+}
+
+func TestBoard_ThreatsAgreeWithOutcomes(t *testing.T) {
+	for index := Index(0); index < IndexCount; index++ {
+		board, _ := FromIndex(index)
+		if board.GetGameStatus() != InProgress {
+			continue
+		}
+
+		for _, player := range []Cell{PlayerX, PlayerO} {
+			for _, position := range board.AvailableMoves() {
+				next := board
+				next.MakeMove(position, player)
+
+				wins := next.CheckWinner() == player
+				if wins != slices.Contains(board.WinningMoves(player), position) {
+					t.Fatalf("board %d: WinningMoves(%s) disagrees at %d", index, player, position)
+				}
+
+				forks := len(next.WinningMoves(player)) >= 2
+				if forks != slices.Contains(board.ForkMoves(player), position) {
+					t.Fatalf("board %d: ForkMoves(%s) disagrees at %d", index, player, position)
+				}
+			}
+		}
+	}
+}
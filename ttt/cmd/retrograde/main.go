@@ -0,0 +1,46 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"ttt/retrograde"
+)
+
+func main() {
+	size := flag.Int("size", 4, "board side length")
+	minPieces := flag.Int("min-pieces", 0, "solve only positions with at least this many stones")
+	out := flag.String("out", "", "path of the table file to write (default tttN.rtb)")
+	flag.Parse()
+
+	if *out == "" {
+		*out = fmt.Sprintf("ttt%d.rtb", *size)
+	}
+
+	table, err := retrograde.Build(*size, *minPieces, func(pieces int, positions int) {
+		fmt.Fprintf(os.Stderr, "%2d stones: %d positions\n", pieces, positions)
+	})
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+
+	file, err := os.Create(*out)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	if _, err := table.WriteTo(file); err != nil {
+		file.Close()
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	if err := file.Close(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	fmt.Fprintf(os.Stderr, "wrote %d positions to %s\n", table.Len(), *out)
+}
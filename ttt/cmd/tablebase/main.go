@@ -0,0 +1,31 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"ttt/players"
+	"ttt/tablebase"
+)
+
+func main() {
+	out := flag.String("out", "ttt3.tb", "path of the tablebase file to write")
+	flag.Parse()
+
+	file, err := os.Create(*out)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	if _, err := tablebase.Build(players.AISolver).WriteTo(file); err != nil {
+		file.Close()
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	if err := file.Close(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
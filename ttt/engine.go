@@ -0,0 +1,90 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"math/rand"
+	"os"
+	"time"
+	"ttt/boards"
+	"ttt/game"
+	"ttt/players"
+	"ttt/protocol"
+)
+
+const engineName = "ttt minimax"
+
+func runEngine(args []string) {
+	flags := flag.NewFlagSet("engine", flag.ExitOnError)
+	seed := flags.Int64("seed", 0, "random seed for tie-breaking; 0 seeds from the clock")
+	flags.Parse(args)
+
+	if *seed == 0 {
+		*seed = time.Now().UnixNano()
+	}
+	random := rand.New(rand.NewSource(*seed))
+
+	err := protocol.Serve(os.Stdin, os.Stdout, engineName, func(symbol boards.Cell) players.Player {
+		return players.NewAIPlayer(symbol, symbol.Opponent(), players.WithRandom(random))
+	})
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+// runMatch plays an external engine against the built-in AI, swapping
+// sides every game, and reports the engine's results.
+func runMatch(args []string) {
+	flags := flag.NewFlagSet("match", flag.ExitOnError)
+	games := flags.Int("games", 10, "number of games")
+	moveTime := flags.Duration("move-time", time.Second, "time limit for each move")
+	flags.Parse(args)
+
+	if flags.NArg() == 0 {
+		fmt.Fprintln(os.Stderr, "usage: ttt match [flags] <engine> [engine args...]")
+		os.Exit(2)
+	}
+
+	random := rand.New(rand.NewSource(time.Now().UnixNano()))
+	var wins, draws, losses int
+	for gameNumber := range *games {
+		side := boards.PlayerX
+		if gameNumber%2 == 1 {
+			side = boards.PlayerO
+		}
+
+		engine, err := protocol.StartEngine(side, *moveTime, flags.Arg(0), flags.Args()[1:]...)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+
+		ai := players.NewAIPlayer(side.Opponent(), side, players.WithRandom(random))
+		playerX, playerO := players.Player(engine), players.Player(ai)
+		if side == boards.PlayerO {
+			playerX, playerO = ai, engine
+		}
+
+		result, err := game.NewGame(playerX, playerO, io.Discard,
+			game.WithTimeControl(game.TimeControl{PerMove: *moveTime})).PlayGame()
+		engine.Close()
+
+		switch {
+		case err != nil:
+			fmt.Printf("game %d: %s as %s: %v\n", gameNumber+1, engine.Name, side, err)
+			losses++
+		case result.Status == boards.Draw:
+			draws++
+		case result.Status == boards.XWins && side == boards.PlayerX,
+			result.Status == boards.OWins && side == boards.PlayerO:
+			wins++
+		default:
+			fmt.Printf("game %d: %s as %s lost (%s)\n", gameNumber+1, engine.Name, side, result.Reason)
+			losses++
+		}
+	}
+
+	fmt.Printf("%d wins, %d draws, %d losses\n", wins, draws, losses)
+}
@@ -0,0 +1,147 @@
+// Package engine holds the rules of a game with no players, clocks or I/O.
+// Front ends push moves in with Apply and show the events that come back,
+// so a console loop, a GUI or a server can all drive the same state.
+package engine
+
+import (
+	"errors"
+	"fmt"
+	"ttt/boards"
+)
+
+var (
+	ErrGameOver = errors.New("game is over")
+	ErrOffBoard = fmt.Errorf("position must be between %d and %d", boards.MinPosition, boards.MaxPosition)
+	ErrOccupied = errors.New("position already taken")
+)
+
+type EventKind int
+
+const (
+	MovePlayed EventKind = iota
+	TurnStarted
+	GameEnded
+)
+
+// Event is one thing that happened to the game. Player is the mover for
+// MovePlayed and the player to move for TurnStarted; Result is only set
+// for GameEnded. Board is the board after the event.
+type Event struct {
+	Kind     EventKind
+	Player   boards.Cell
+	Position int
+	Board    boards.Board
+	Result   Result
+}
+
+type State struct {
+	board  boards.Board
+	turn   boards.Cell
+	result *Result
+}
+
+func New(first boards.Cell) *State {
+	return &State{board: boards.NewBoard(), turn: first}
+}
+
+func (state *State) Clone() *State {
+	clone := *state
+	if state.result != nil {
+		result := *state.result
+		clone.result = &result
+	}
+	return &clone
+}
+
+func (state *State) Board() boards.Board {
+	return state.board
+}
+
+// Turn is the player to move, or boards.Empty once the game is over.
+func (state *State) Turn() boards.Cell {
+	if state.result != nil {
+		return boards.Empty
+	}
+	return state.turn
+}
+
+// Legal lists the empty positions, or nothing once the game is over.
+func (state *State) Legal() []int {
+	if state.result != nil {
+		return nil
+	}
+	return state.board.AvailableMoves()
+}
+
+// Status counts forfeits as wins for the other side; a stopped game stays
+// boards.InProgress.
+func (state *State) Status() boards.GameStatus {
+	if state.result != nil {
+		return state.result.Status
+	}
+	return boards.InProgress
+}
+
+func (state *State) Result() (Result, bool) {
+	if state.result == nil {
+		return Result{}, false
+	}
+	return *state.result, true
+}
+
+// Check reports why position cannot be played now, or nil if it can.
+func (state *State) Check(position int) error {
+	if state.result != nil {
+		return ErrGameOver
+	}
+	if position < boards.MinPosition || position > boards.MaxPosition {
+		return ErrOffBoard
+	}
+	if state.board.At(position) != boards.Empty {
+		return ErrOccupied
+	}
+	return nil
+}
+
+// Apply plays position for the player to move. An illegal move leaves the
+// state unchanged.
+func (state *State) Apply(position int) ([]Event, error) {
+	if err := state.Check(position); err != nil {
+		return nil, err
+	}
+
+	mover := state.turn
+	state.board.MakeMove(position, mover)
+	events := []Event{{Kind: MovePlayed, Player: mover, Position: position, Board: state.board}}
+
+	if status := state.board.GetGameStatus(); status != boards.InProgress {
+		_, lines := state.board.WinningLines()
+		return append(events, state.end(Result{Status: status, Reason: Completed, Lines: lines})), nil
+	}
+
+	state.turn = mover.Opponent()
+	return append(events, Event{Kind: TurnStarted, Player: state.turn, Board: state.board}), nil
+}
+
+// Concede ends the game as a loss for the player to move, for reason such
+// as Forfeited or OutOfTime. It does nothing once the game is over.
+func (state *State) Concede(reason EndReason) []Event {
+	if state.result != nil {
+		return nil
+	}
+	return []Event{state.end(Result{Status: winStatusFor(state.turn.Opponent()), Reason: reason, Player: state.turn})}
+}
+
+// Stop ends the game without a winner, blaming the player to move. It does
+// nothing once the game is over.
+func (state *State) Stop(reason EndReason) []Event {
+	if state.result != nil {
+		return nil
+	}
+	return []Event{state.end(Result{Status: boards.InProgress, Reason: reason, Player: state.turn})}
+}
+
+func (state *State) end(result Result) Event {
+	state.result = &result
+	return Event{Kind: GameEnded, Player: result.Player, Board: state.board, Result: result}
+}
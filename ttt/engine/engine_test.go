@@ -0,0 +1,121 @@
+package engine
+
+import (
+	"errors"
+	"testing"
+	"ttt/boards"
+)
+
+func applyAll(t *testing.T, state *State, positions ...int) []Event {
+	t.Helper()
+	var events []Event
+	for _, position := range positions {
+		applied, err := state.Apply(position)
+		if err != nil {
+			t.Fatalf("move %d: %v", position, err)
+		}
+		events = append(events, applied...)
+	}
+	return events
+}
+
+func TestApply_AlternatesTurns(t *testing.T) {
+	state := New(boards.PlayerO)
+
+	events := applyAll(t, state, 5)
+
+	if len(events) != 2 || events[0].Kind != MovePlayed || events[0].Player != boards.PlayerO || events[0].Position != 5 {
+		t.Fatalf("should report O's move first, got %+v", events)
+	}
+
+	if events[1].Kind != TurnStarted || events[1].Player != boards.PlayerX || state.Turn() != boards.PlayerX {
+		t.Errorf("X should be to move next, got %+v", events[1])
+	}
+
+	if state.Board().At(5) != boards.PlayerO || len(state.Legal()) != 8 {
+		t.Error("board and legal moves should reflect the move")
+	}
+}
+
+func TestApply_EndsOnWin(t *testing.T) {
+	state := New(boards.PlayerX)
+
+	events := applyAll(t, state, 1, 4, 2, 5, 3)
+
+	last := events[len(events)-1]
+	if last.Kind != GameEnded || last.Result.Status != boards.XWins || last.Result.Reason != Completed {
+		t.Fatalf("should end with an X win, got %+v", last)
+	}
+
+	if len(last.Result.Lines) != 1 || last.Result.Lines[0] != (boards.Line{1, 2, 3}) {
+		t.Errorf("should report the top row, got %v", last.Result.Lines)
+	}
+
+	if state.Status() != boards.XWins || state.Turn() != boards.Empty || state.Legal() != nil {
+		t.Error("a finished game should have no turn or legal moves")
+	}
+
+	if _, err := state.Apply(9); !errors.Is(err, ErrGameOver) {
+		t.Errorf("moves after the end should fail, got %v", err)
+	}
+}
+
+func TestApply_RejectsIllegalMovesWithoutChange(t *testing.T) {
+	state := New(boards.PlayerX)
+	applyAll(t, state, 5)
+
+	if _, err := state.Apply(5); !errors.Is(err, ErrOccupied) {
+		t.Errorf("taken square should be rejected, got %v", err)
+	}
+
+	if _, err := state.Apply(10); !errors.Is(err, ErrOffBoard) {
+		t.Errorf("position 10 should be rejected, got %v", err)
+	}
+
+	if state.Turn() != boards.PlayerO || len(state.Legal()) != 8 {
+		t.Error("illegal moves should leave the state unchanged")
+	}
+}
+
+func TestConcede_LosesForPlayerToMove(t *testing.T) {
+	state := New(boards.PlayerX)
+	applyAll(t, state, 5)
+
+	events := state.Concede(OutOfTime)
+
+	result, ok := state.Result()
+	if len(events) != 1 || !ok || result.Status != boards.XWins || result.Reason != OutOfTime || result.Player != boards.PlayerO {
+		t.Errorf("O should lose on time, got %+v", result)
+	}
+
+	if state.Concede(Forfeited) != nil || state.Stop(Aborted) != nil {
+		t.Error("ending a finished game should do nothing")
+	}
+}
+
+func TestStop_LeavesNoWinner(t *testing.T) {
+	state := New(boards.PlayerX)
+
+	state.Stop(Aborted)
+
+	result, ok := state.Result()
+	if !ok || state.Status() != boards.InProgress || result.Reason != Aborted || result.Player != boards.PlayerX {
+		t.Errorf("stopped game should have no winner, got %+v", result)
+	}
+}
+
+func TestClone_IsIndependent(t *testing.T) {
+	state := New(boards.PlayerX)
+	applyAll(t, state, 1)
+
+	clone := state.Clone()
+	applyAll(t, clone, 4, 2, 5, 3)
+
+	if state.Board().At(4) != boards.Empty || state.Turn() != boards.PlayerO {
+		t.Error("moves on the clone should not touch the original")
+	}
+
+	if _, over := state.Result(); over {
+		t.Error("the original should still be in progress")
+	}
+}
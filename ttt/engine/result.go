@@ -0,0 +1,47 @@
+package engine
+
+import "ttt/boards"
+
+type EndReason int
+
+const (
+	Completed EndReason = iota
+	Aborted
+	Forfeited
+	IllegalMove
+	IllegalMoveForfeit
+	OutOfTime
+)
+
+type Result struct {
+	Status boards.GameStatus
+	Reason EndReason
+	Player boards.Cell // the player who aborted, forfeited, moved illegally or ran out of time
+	Lines  []boards.Line
+}
+
+func (reason EndReason) String() string {
+	switch reason {
+	case Completed:
+		return "completed"
+	case Aborted:
+		return "aborted"
+	case Forfeited:
+		return "forfeited"
+	case IllegalMove:
+		return "illegal move"
+	case IllegalMoveForfeit:
+		return "forfeited on illegal move"
+	case OutOfTime:
+		return "lost on time"
+	default:
+		return "unknown"
+	}
+}
+
+func winStatusFor(player boards.Cell) boards.GameStatus {
+	if player == boards.PlayerX {
+		return boards.XWins
+	}
+	return boards.OWins
+}
@@ -0,0 +1,53 @@
+package game
+
+import (
+	"math"
+	"time"
+	"ttt/boards"
+)
+
+// TimeControl limits thinking time. Total is each player's budget for the
+// whole game, topped up by Increment after every move; PerMove caps any
+// single move. Zero durations are unlimited.
+type TimeControl struct {
+	Total     time.Duration
+	Increment time.Duration
+	PerMove   time.Duration
+}
+
+func (control TimeControl) Enabled() bool {
+	return control.Total > 0 || control.PerMove > 0
+}
+
+type Clock struct {
+	control   TimeControl
+	remaining map[boards.Cell]time.Duration
+}
+
+func NewClock(control TimeControl) *Clock {
+	return &Clock{
+		control: control,
+		remaining: map[boards.Cell]time.Duration{
+			boards.PlayerX: control.Total,
+			boards.PlayerO: control.Total,
+		},
+	}
+}
+
+// Budget is how long player may think about the next move.
+func (clock *Clock) Budget(player boards.Cell) time.Duration {
+	budget := time.Duration(math.MaxInt64)
+	if clock.control.Total > 0 {
+		budget = clock.remaining[player]
+	}
+	if clock.control.PerMove > 0 {
+		budget = min(budget, clock.control.PerMove)
+	}
+	return budget
+}
+
+func (clock *Clock) Spend(player boards.Cell, elapsed time.Duration) {
+	if clock.control.Total > 0 {
+		clock.remaining[player] += clock.control.Increment - elapsed
+	}
+}
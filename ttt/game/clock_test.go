@@ -0,0 +1,214 @@
+package game
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"math/rand"
+	"strings"
+	"testing"
+	"time"
+	"ttt/boards"
+	"ttt/players"
+)
+
+func TestClock_TotalWithIncrement(t *testing.T) {
+	clock := NewClock(TimeControl{Total: time.Minute, Increment: 2 * time.Second})
+
+	clock.Spend(boards.PlayerX, 10*time.Second)
+
+	if budget := clock.Budget(boards.PlayerX); budget != 52*time.Second {
+		t.Errorf("expected 52s left for X, got %v", budget)
+	}
+	if budget := clock.Budget(boards.PlayerO); budget != time.Minute {
+		t.Errorf("O's clock should not run on X's move, got %v", budget)
+	}
+}
+
+func TestClock_PerMoveCapsBudget(t *testing.T) {
+	clock := NewClock(TimeControl{Total: time.Minute, PerMove: 5 * time.Second})
+
+	if budget := clock.Budget(boards.PlayerX); budget != 5*time.Second {
+		t.Errorf("expected the per-move cap, got %v", budget)
+	}
+
+	perMoveOnly := NewClock(TimeControl{PerMove: 5 * time.Second})
+	perMoveOnly.Spend(boards.PlayerX, 4*time.Second)
+	if budget := perMoveOnly.Budget(boards.PlayerX); budget != 5*time.Second {
+		t.Errorf("a per-move limit should reset every move, got %v", budget)
+	}
+}
+
+func TestTimeControl_DisabledByDefault(t *testing.T) {
+	game := NewGame(&scriptedPlayer{}, &scriptedPlayer{}, io.Discard, WithTimeControl(TimeControl{Increment: time.Second}))
+
+	if game.Clock() != nil {
+		t.Error("an increment alone should not start a clock")
+	}
+}
+
+type sleepyPlayer struct {
+	delay time.Duration
+}
+
+func (player *sleepyPlayer) ReadMove(board boards.Board) (int, error) {
+	time.Sleep(player.delay)
+	return board.AvailableMoves()[0], nil
+}
+
+func TestTimeControl_SlowPlayerLosesOnTime(t *testing.T) {
+	var output bytes.Buffer
+	game := NewGame(&scriptedPlayer{moves: []int{1}}, &sleepyPlayer{delay: time.Second}, &output,
+		WithTimeControl(TimeControl{PerMove: 20 * time.Millisecond}))
+
+	start := time.Now()
+	result, err := game.PlayGame()
+
+	if err != nil {
+		t.Fatalf("losing on time is a normal result, got %v", err)
+	}
+	if result.Reason != OutOfTime || result.Player != boards.PlayerO || result.Status != boards.XWins {
+		t.Errorf("expected O to lose on time, got %+v", result)
+	}
+	if time.Since(start) > 500*time.Millisecond {
+		t.Error("the game should end when the clock runs out, not when the player answers")
+	}
+	if !strings.Contains(output.String(), "Player O ran out of time.") {
+		t.Errorf("expected the timeout in the output, got:\n%s", output.String())
+	}
+}
+
+func TestTimeControl_TurnShowsTimeLeft(t *testing.T) {
+	var output bytes.Buffer
+	game := NewGame(&scriptedPlayer{moves: []int{1, 2, 3}}, &scriptedPlayer{moves: []int{4, 5}}, &output,
+		WithTimeControl(TimeControl{Total: 5 * time.Minute}))
+
+	game.PlayGame()
+
+	if !strings.Contains(output.String(), "Player X's turn (5:00.0 left)") {
+		t.Errorf("expected the clock in the turn line, got:\n%s", output.String())
+	}
+}
+
+func TestTimeControl_AIMovesWithinDeadline(t *testing.T) {
+	game := NewGame(
+		players.NewAIPlayer(boards.PlayerX, boards.PlayerO),
+		players.NewAIPlayer(boards.PlayerO, boards.PlayerX),
+		io.Discard,
+		WithTimeControl(TimeControl{PerMove: 100 * time.Millisecond}))
+
+	result, err := game.PlayGame()
+
+	if err != nil || result.Reason != Completed {
+		t.Errorf("the AI should search within its deadline instead of losing on time, got %+v (%v)", result, err)
+	}
+}
+
+func TestTimeControl_SessionReusesLineTypedAfterTimeout(t *testing.T) {
+	reader, writer := io.Pipe()
+	go func() {
+		writer.Write([]byte("1\n1\n2\n"))
+		time.Sleep(100 * time.Millisecond)
+		writer.Write([]byte("n\n"))
+	}()
+
+	var output bytes.Buffer
+	options := testSessionOptions()
+	options.TimeControl = TimeControl{PerMove: 20 * time.Millisecond}
+	PlaySession(bufio.NewReader(reader), &output, options)
+
+	if !strings.Contains(output.String(), "Player X ran out of time.") {
+		t.Errorf("expected X to lose on time, got:\n%s", output.String())
+	}
+	if strings.Contains(output.String(), "Invalid input") || !strings.HasSuffix(output.String(), "Thanks for playing!\n") {
+		t.Errorf("the late line should answer the play again prompt, got:\n%s", output.String())
+	}
+}
+
+// slowLearner stands in for a learning player: its moves draw from a shared
+// random and add to its own history, which GameEnded reads.
+type slowLearner struct {
+	random  *rand.Rand
+	delay   time.Duration
+	history []int
+	learned int
+	ended   chan struct{}
+}
+
+func (player *slowLearner) ReadMove(board boards.Board) (int, error) {
+	time.Sleep(player.delay)
+	moves := board.AvailableMoves()
+	move := moves[player.random.Intn(len(moves))]
+	player.history = append(player.history, move)
+	return move, nil
+}
+
+func (player *slowLearner) GameEnded(status boards.GameStatus, board boards.Board) {
+	player.learned = len(player.history)
+	close(player.ended)
+}
+
+// Run with -race: the move abandoned by the clock must not overlap
+// GameEnded or later use of the shared random.
+func TestTimeControl_AbandonedMoveDoesNotRace(t *testing.T) {
+	random := NewRandom(1)
+	learner := &slowLearner{random: random, delay: 50 * time.Millisecond, ended: make(chan struct{})}
+	game := NewGame(&scriptedPlayer{moves: []int{1}}, learner, io.Discard,
+		WithTimeControl(TimeControl{PerMove: 10 * time.Millisecond}))
+
+	result, _ := game.PlayGame()
+	random.Intn(9)
+
+	if result.Reason != OutOfTime || result.Player != boards.PlayerO {
+		t.Fatalf("expected O to lose on time, got %+v", result)
+	}
+
+	select {
+	case <-learner.ended:
+	case <-time.After(time.Second):
+		t.Fatal("GameEnded should arrive once the abandoned move returns")
+	}
+	if learner.learned != 1 {
+		t.Errorf("GameEnded should run after the abandoned move finished, saw %d moves", learner.learned)
+	}
+}
+
+// hungNotifier never returns from ReadMove but still wants to hear the end.
+type hungNotifier struct {
+	ended chan struct{}
+}
+
+func (player *hungNotifier) ReadMove(board boards.Board) (int, error) {
+	select {}
+}
+
+func (player *hungNotifier) GameEnded(status boards.GameStatus, board boards.Board) {
+	close(player.ended)
+}
+
+func TestTimeControl_HungNotifierDoesNotBlockGameEnd(t *testing.T) {
+	bot := &hungNotifier{ended: make(chan struct{})}
+	game := NewGame(&scriptedPlayer{moves: []int{1}}, bot, io.Discard,
+		WithTimeControl(TimeControl{PerMove: 50 * time.Millisecond}))
+
+	done := make(chan GameResult, 1)
+	go func() {
+		result, _ := game.PlayGame()
+		done <- result
+	}()
+
+	select {
+	case result := <-done:
+		if result.Reason != OutOfTime || result.Player != boards.PlayerO {
+			t.Errorf("expected O to lose on time, got %+v", result)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("PlayGame should return while the bot is still stuck in ReadMove")
+	}
+
+	select {
+	case <-bot.ended:
+		t.Error("GameEnded should wait for the stuck read")
+	default:
+	}
+}
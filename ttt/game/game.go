@@ -2,122 +2,384 @@ package game
 
 import (
 	"bufio"
+	"context"
+	"errors"
+	"fmt"
 	"io"
+	"math/rand"
+	"os"
+	"strings"
+	"time"
 	"ttt/boards"
+	"ttt/engine"
 	tttio "ttt/io"
 	"ttt/players"
 )
 
+// Game drives an engine.State from the console: it asks the player to move
+// for a position, applies it to the engine state and passes the resulting
+// events to observers.
 type Game struct {
-	board         boards.Board
-	playerX       players.Player
-	playerO       players.Player
-	output        io.Writer
-	currentPlayer string
+	state             *engine.State
+	playerX           players.Player
+	playerO           players.Player
+	observers         []GameObserver
+	firstPlayer       boards.Cell
+	illegalMovePolicy IllegalMovePolicy
+	roster            tttio.Roster
+	renderer          tttio.Renderer
+	random            *rand.Rand
+	clock             *Clock
+	substituted       int
+	adapters          map[boards.Cell]players.ContextPlayer
+	playerKinds       map[boards.Cell]string
+	kindOptions       map[string]map[string]string
 }
 
 func NewGame(
 	playerX players.Player,
 	playerO players.Player,
-	output io.Writer) *Game {
-	return &Game{
-		board:         boards.NewBoard(),
-		playerX:       playerX,
-		playerO:       playerO,
-		output:        output,
-		currentPlayer: boards.PlayerX,
+	output io.Writer,
+	options ...Option) *Game {
+	game := &Game{
+		playerX:     playerX,
+		playerO:     playerO,
+		firstPlayer: boards.PlayerX,
+		roster:      tttio.DefaultRoster(),
+		renderer:    tttio.PlainRenderer{},
+	}
+
+	for _, option := range options {
+		option(game)
+	}
+	game.state = engine.New(game.firstPlayer)
+
+	console := NewConsoleObserver(output, game.roster, game.renderer)
+	console.clock = game.clock
+	game.observers = append([]GameObserver{
+		console,
+		&playerNotifier{game: game},
+	}, game.observers...)
+	return game
+}
+
+// Clock is nil when the game has no time control.
+func (game *Game) Clock() *Clock {
+	return game.clock
+}
+
+func (game *Game) AddObserver(observer GameObserver) {
+	game.observers = append(game.observers, observer)
+}
+
+func (game *Game) notifyGameStart() {
+	for _, observer := range game.observers {
+		observer.OnGameStart(game.state.Board())
+	}
+}
+
+func (game *Game) notifyTurnStart() {
+	for _, observer := range game.observers {
+		observer.OnTurnStart(game.state.Turn(), game.state.Board())
+	}
+}
+
+func (game *Game) notifyInvalidMove(position int, err error) {
+	for _, observer := range game.observers {
+		observer.OnInvalidMove(game.state.Turn(), position, err)
+	}
+}
+
+func (game *Game) dispatch(events []engine.Event) {
+	for _, event := range events {
+		for _, observer := range game.observers {
+			switch event.Kind {
+			case engine.MovePlayed:
+				observer.OnMove(event.Player, event.Position, event.Board)
+			case engine.TurnStarted:
+				observer.OnTurnStart(event.Player, event.Board)
+			case engine.GameEnded:
+				result, _ := game.result()
+				observer.OnGameEnd(result, event.Board)
+			}
+		}
 	}
 }
 
 func (game *Game) getCurrentPlayer() players.Player {
-	if game.currentPlayer == boards.PlayerX {
+	if game.state.Turn() == boards.PlayerX {
 		return game.playerX
 	}
 	return game.playerO
 }
 
-func (game *Game) switchPlayer() {
-	if game.currentPlayer == boards.PlayerX {
-		game.currentPlayer = boards.PlayerO
-	} else {
-		game.currentPlayer = boards.PlayerX
+func (game *Game) result() (GameResult, bool) {
+	result, over := game.state.Result()
+	return GameResult{Result: result, Substituted: game.substituted}, over
+}
+
+func (game *Game) endGame(events []engine.Event, err error) (GameResult, error) {
+	game.dispatch(events)
+	result, _ := game.result()
+	return result, err
+}
+
+func (game *Game) handleReadError(err error) (GameResult, error) {
+	if errors.Is(err, errOutOfTime) {
+		return game.endGame(game.state.Concede(OutOfTime), nil)
+	}
+
+	if errors.Is(err, players.ErrForfeit) {
+		return game.endGame(game.state.Concede(Forfeited), nil)
 	}
+
+	player := game.state.Turn()
+	return game.endGame(game.state.Stop(Aborted), fmt.Errorf("%w: player %s: %w", ErrAborted, player, err))
+}
+
+func (game *Game) handleIllegalMove(position int, err error) (GameResult, error) {
+	player := game.state.Turn()
+	return game.endGame(game.state.Stop(IllegalMove),
+		fmt.Errorf("%w: player %s chose %d: %w", ErrIllegalMove, player, position, err))
+}
+
+func (game *Game) forfeitIllegalMove() (GameResult, error) {
+	return game.endGame(game.state.Concede(IllegalMoveForfeit), nil)
 }
 
-func (game *Game) displayEndResult(status boards.GameStatus) {
-	switch status {
-	case boards.XWins:
-		tttio.ShowWinner(game.output, boards.PlayerX)
-	case boards.OWins:
-		tttio.ShowWinner(game.output, boards.PlayerO)
-	case boards.Draw:
-		tttio.ShowDraw(game.output)
+// readMove runs the current player's clock around the read. Running out of
+// time beats a late answer; a cancelled ctx still aborts the game.
+func (game *Game) readMove(ctx context.Context) (int, error) {
+	player := game.adapters[game.state.Turn()]
+	if game.clock == nil {
+		return player.ReadMoveContext(ctx, game.state.Board())
+	}
+
+	current := game.state.Turn()
+	budget := game.clock.Budget(current)
+	moveCtx, cancel := context.WithTimeout(ctx, budget)
+	defer cancel()
+
+	start := time.Now()
+	position, err := player.ReadMoveContext(moveCtx, game.state.Board())
+	elapsed := time.Since(start)
+
+	if ctx.Err() == nil && (elapsed > budget || errors.Is(err, context.DeadlineExceeded)) {
+		return 0, errOutOfTime
 	}
+
+	game.clock.Spend(current, elapsed)
+	return position, err
 }
 
-func (game *Game) playTurns() {
+// placeMove settles an illegal position by the game's policy and returns
+// the position that was accepted, or the result when the policy ended the
+// game.
+func (game *Game) placeMove(ctx context.Context, position int) (accepted int, result GameResult, ended bool, err error) {
+	retries := 0
+
 	for {
-		tttio.ShowPlayerTurn(game.output, game.currentPlayer)
+		moveErr := game.state.Check(position)
+		if moveErr == nil {
+			return position, GameResult{}, false, nil
+		}
 
-		position, err := game.getCurrentPlayer().ReadMove(game.board)
-		if err != nil {
-			break
+		game.notifyInvalidMove(position, moveErr)
+
+		switch game.illegalMovePolicy.Rule {
+		case ForfeitOnIllegalMove:
+			result, err = game.forfeitIllegalMove()
+			return 0, result, true, err
+		case RetryIllegalMove:
+			if retries >= game.illegalMovePolicy.Retries {
+				result, err = game.forfeitIllegalMove()
+				return 0, result, true, err
+			}
+			retries++
+
+			position, err = game.readMove(ctx)
+			if err != nil {
+				result, err = game.handleReadError(err)
+				return 0, result, true, err
+			}
+		case SubstituteRandomMove:
+			position = game.illegalMovePolicy.randomMove(game.state.Legal())
+			game.substituted++
+		default:
+			result, err = game.handleIllegalMove(position, moveErr)
+			return 0, result, true, err
 		}
+	}
+}
 
-		err = game.board.MakeMove(position, game.currentPlayer)
+func (game *Game) playTurns(ctx context.Context) (GameResult, error) {
+	game.notifyTurnStart()
+
+	for {
+		position, err := game.readMove(ctx)
 		if err != nil {
-			break
+			return game.handleReadError(err)
 		}
 
-		tttio.ShowBoard(game.output, game.board)
+		position, result, ended, err := game.placeMove(ctx, position)
+		if ended {
+			return result, err
+		}
 
-		status := game.board.GetGameStatus()
-		if status != boards.InProgress {
-			game.displayEndResult(status)
-			break
+		events, err := game.state.Apply(position)
+		if err != nil {
+			return game.handleIllegalMove(position, err)
 		}
 
-		game.switchPlayer()
+		game.dispatch(events)
+		if result, over := game.result(); over {
+			return result, nil
+		}
 	}
 }
 
-func (game *Game) PlayGame() {
-	tttio.ShowWelcome(game.output)
-	tttio.ShowBoard(game.output, game.board)
-	game.playTurns()
+func (game *Game) PlayGame() (GameResult, error) {
+	return game.PlayGameContext(context.Background())
+}
+
+// PlayGameContext aborts the game, blaming the player to move, when ctx
+// ends while a move is awaited.
+func (game *Game) PlayGameContext(ctx context.Context) (GameResult, error) {
+	game.adapters = map[boards.Cell]players.ContextPlayer{
+		boards.PlayerX: players.AdaptContext(game.playerX),
+		boards.PlayerO: players.AdaptContext(game.playerO),
+	}
+	game.notifyGameStart()
+	return game.playTurns(ctx)
 }
 
-func BuildGame(reader *bufio.Reader, output io.Writer) *Game {
-	tttio.ShowPlayerTypeSelection(output, boards.PlayerX)
-	playerXType, _ := tttio.ReadPlayerType(reader, output)
+func FirstPlayerFor(choice tttio.FirstPlayerChoice, gameNumber int, random *rand.Rand) boards.Cell {
+	switch choice {
+	case tttio.OFirst:
+		return boards.PlayerO
+	case tttio.RandomFirst:
+		if random.Intn(2) == 0 {
+			return boards.PlayerX
+		}
+		return boards.PlayerO
+	case tttio.AlternateFirst:
+		if gameNumber%2 == 1 {
+			return boards.PlayerO
+		}
+		return boards.PlayerX
+	default:
+		return boards.PlayerX
+	}
+}
 
-	tttio.ShowPlayerTypeSelection(output, boards.PlayerO)
-	playerOType, _ := tttio.ReadPlayerType(reader, output)
+func BuildGame(reader *bufio.Reader, output io.Writer, options ...Option) *Game {
+	game := NewGame(nil, nil, output, options...)
+	game.playerX = game.choosePlayer(boards.PlayerX, reader, output)
+	game.playerO = game.choosePlayer(boards.PlayerO, reader, output)
 
 	tttio.ShowNewline(output)
+	return game
+}
+
+// choosePlayer builds the kind given by WithPlayerKinds, asking from the
+// registry's menu when none was given or it could not be built.
+func (game *Game) choosePlayer(symbol boards.Cell, reader *bufio.Reader, output io.Writer) players.Player {
+	name := game.playerKinds[symbol]
+	for {
+		if name == "" {
+			items := players.MenuItems()
+			tttio.ShowPlayerTypeSelection(output, symbol, items)
+			choice, _ := tttio.ReadPlayerType(reader, output, items)
+			name = players.Kinds()[choice].Name
+		}
+
+		player, err := players.CreatePlayer(name, players.Seat{
+			Symbol:  symbol,
+			Reader:  reader,
+			Output:  output,
+			Random:  game.random,
+			Options: game.kindOptions[name],
+		})
+		if err == nil {
+			return player
+		}
+
+		tttio.ShowInvalidInput(output, err)
+		name = ""
+	}
+}
+
+type SessionOptions struct {
+	Random      *rand.Rand
+	Roster      tttio.Roster
+	Renderer    tttio.Renderer
+	TimeControl TimeControl
+	PlayerX     string // registered kind names; empty asks each game
+	PlayerO     string
+	KindOptions map[string]map[string]string
+}
+
+// reclaimInput passes on a line a human was still typing when their move was
+// cut short by the clock, so that the next prompt reads it instead of a
+// background read racing the prompt for it.
+func reclaimInput(reader *bufio.Reader, game *Game) *bufio.Reader {
+	for _, player := range []players.Player{game.playerX, game.playerO} {
+		human, ok := player.(*players.HumanPlayer)
+		if !ok {
+			continue
+		}
 
-	playerX := players.CreatePlayer(playerXType, boards.PlayerX, boards.PlayerO, reader, output)
-	playerO := players.CreatePlayer(playerOType, boards.PlayerO, boards.PlayerX, reader, output)
+		if line, ok := human.TakePending(); ok {
+			return bufio.NewReader(io.MultiReader(strings.NewReader(line), reader))
+		}
+	}
+	return reader
+}
 
-	return NewGame(playerX, playerO, output)
+func PlaySession(reader *bufio.Reader, output io.Writer, options SessionOptions) {
+	PlaySessionContext(context.Background(), reader, output, options)
 }
 
-func StartGame() {
-	// reader := bufio.NewReader(os.Stdin)
-	// output := os.Stdout
+func PlaySessionContext(ctx context.Context, reader *bufio.Reader, output io.Writer, options SessionOptions) {
+	tttio.ShowNewline(output)
+	tttio.ShowFirstPlayerSelection(output)
+	firstPlayerChoice, _ := tttio.ReadFirstPlayer(reader, output)
+
+	for gameNumber := 0; ; gameNumber++ {
+		tttio.ShowNewline(output)
+
+		firstPlayer := FirstPlayerFor(firstPlayerChoice, gameNumber, options.Random)
+		game := BuildGame(reader, output,
+			WithFirstPlayer(firstPlayer),
+			WithRandom(options.Random),
+			WithRoster(options.Roster),
+			WithRenderer(options.Renderer),
+			WithTimeControl(options.TimeControl),
+			WithPlayerKinds(options.PlayerX, options.PlayerO),
+			WithKindOptions(options.KindOptions))
+		_, err := game.PlayGameContext(ctx)
+		if err != nil {
+			tttio.ShowGameError(output, err)
+		}
 
-	// for {
-	// 	tttio.ShowNewline(output)
+		if errors.Is(err, ErrAborted) {
+			tttio.ShowGoodbye(output)
+			return
+		}
 
-	// 	game := BuildGame(reader, output)
-	// 	game.PlayGame()
+		tttio.ShowPlayAgainPrompt(output)
+		reader = reclaimInput(reader, game)
+		playAgain, err := tttio.ReadPlayAgain(reader, output)
 
-	// 	tttio.ShowPlayAgainPrompt(output)
-	// 	playAgain, err := tttio.ReadPlayAgain(reader, output)
+		if err != nil || !playAgain {
+			tttio.ShowGoodbye(output)
+			return
+		}
+	}
+}
 
-	// 	if err != nil || !playAgain {
-	// 		tttio.ShowGoodbye(output)
-	// 		break
-	// 	}
-	// }
+func StartGame(colorMode tttio.ColorMode, options SessionOptions) {
+	options.Random = NewRandom(time.Now().UnixNano())
+	options.Renderer = tttio.ChooseRenderer(colorMode, os.Stdout)
+	PlaySession(bufio.NewReader(os.Stdin), os.Stdout, options)
 }
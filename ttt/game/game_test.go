@@ -3,8 +3,13 @@ package game
 import (
 	"bufio"
 	"bytes"
+	"context"
+	"errors"
+	"io"
+	"math/rand"
 	"strings"
 	"testing"
+	"ttt/boards"
 	tttio "ttt/io"
 	"ttt/players"
 )
@@ -549,3 +554,297 @@ func TestStartGame_GoodbyeMessageWhenDecliningReplay(t *testing.T) {
 		t.Error("should show goodbye message when declining replay")
 	}
 }
+
+type forfeitingPlayer struct{}
+
+func (player *forfeitingPlayer) ReadMove(board boards.Board) (int, error) {
+	return 0, players.ErrForfeit
+}
+
+func TestGame_ReturnsCompletedResult(t *testing.T) {
+	game := NewGame(
+		&scriptedPlayer{moves: []int{1, 2, 3}},
+		&scriptedPlayer{moves: []int{4, 5}},
+		io.Discard)
+
+	result, err := game.PlayGame()
+
+	if err != nil {
+		t.Fatalf("completed game should not return error: %v", err)
+	}
+
+	if result.Status != boards.XWins || result.Reason != Completed {
+		t.Errorf("should complete with X winning, got %+v", result)
+	}
+}
+
+func TestGame_AbortsOnReadError(t *testing.T) {
+	game := NewGame(
+		&scriptedPlayer{moves: []int{1}},
+		&scriptedPlayer{},
+		io.Discard)
+
+	result, err := game.PlayGame()
+
+	if !errors.Is(err, ErrAborted) {
+		t.Errorf("should return ErrAborted, got %v", err)
+	}
+
+	if !errors.Is(err, io.EOF) {
+		t.Errorf("should wrap the player's error, got %v", err)
+	}
+
+	if result.Reason != Aborted || result.Player != boards.PlayerO {
+		t.Errorf("should record O aborting, got %+v", result)
+	}
+}
+
+func TestGame_ForfeitAwardsOpponent(t *testing.T) {
+	var output bytes.Buffer
+	game := NewGame(&scriptedPlayer{moves: []int{1}}, &forfeitingPlayer{}, &output)
+
+	result, err := game.PlayGame()
+
+	if err != nil {
+		t.Fatalf("forfeit should not return error: %v", err)
+	}
+
+	if result.Status != boards.XWins || result.Reason != Forfeited || result.Player != boards.PlayerO {
+		t.Errorf("O forfeiting should give X the win, got %+v", result)
+	}
+
+	if !strings.Contains(output.String(), "Player O forfeits") {
+		t.Error("should announce the forfeit")
+	}
+}
+
+func TestGame_StopsOnIllegalMove(t *testing.T) {
+	game := NewGame(
+		&scriptedPlayer{moves: []int{1}},
+		&scriptedPlayer{moves: []int{12}},
+		io.Discard)
+
+	result, err := game.PlayGame()
+
+	if !errors.Is(err, ErrIllegalMove) {
+		t.Errorf("should return ErrIllegalMove, got %v", err)
+	}
+
+	if result.Reason != IllegalMove || result.Status != boards.InProgress {
+		t.Errorf("should record an unfinished illegal-move result, got %+v", result)
+	}
+}
+
+func TestPlaySession_ReportsAbortAndStops(t *testing.T) {
+	var output bytes.Buffer
+	reader := bufio.NewReader(strings.NewReader("1\n1\n1\n1\n4\n"))
+
+	PlaySession(reader, &output, testSessionOptions())
+
+	result := output.String()
+	if !strings.Contains(result, "Game ended early") {
+		t.Error("should report that the game ended early")
+	}
+
+	if strings.Contains(result, "Play again") {
+		t.Error("should not offer a rematch after input is exhausted")
+	}
+
+	if !strings.Contains(result, "Thanks for playing") {
+		t.Error("should say goodbye")
+	}
+}
+
+func TestPlaySession_PlaysUntilDeclined(t *testing.T) {
+	var output bytes.Buffer
+	reader := bufio.NewReader(strings.NewReader("1\n1\n1\n1\n4\n2\n5\n3\nn\n"))
+
+	PlaySession(reader, &output, testSessionOptions())
+
+	result := output.String()
+	if !strings.Contains(result, "Player X wins") {
+		t.Error("should play the game to a win")
+	}
+
+	if strings.Contains(result, "Game ended early") {
+		t.Error("completed game should not report an error")
+	}
+
+	if !strings.Contains(result, "Thanks for playing") {
+		t.Error("should say goodbye when declining replay")
+	}
+}
+
+func TestGame_OCanMoveFirst(t *testing.T) {
+	game := NewGame(
+		&scriptedPlayer{moves: []int{4, 5}},
+		&scriptedPlayer{moves: []int{1, 2, 3}},
+		io.Discard,
+		WithFirstPlayer(boards.PlayerO))
+
+	result, err := game.PlayGame()
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if result.Status != boards.OWins {
+		t.Errorf("O moving first should win the top row, got %v", result.Status)
+	}
+}
+
+func TestFirstPlayerFor_FixedChoices(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+
+	if FirstPlayerFor(tttio.XFirst, 3, random) != boards.PlayerX {
+		t.Error("XFirst should always start with X")
+	}
+
+	if FirstPlayerFor(tttio.OFirst, 0, random) != boards.PlayerO {
+		t.Error("OFirst should always start with O")
+	}
+}
+
+func TestFirstPlayerFor_Alternates(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	expected := []boards.Cell{boards.PlayerX, boards.PlayerO, boards.PlayerX, boards.PlayerO}
+
+	for gameNumber, want := range expected {
+		if got := FirstPlayerFor(tttio.AlternateFirst, gameNumber, random); got != want {
+			t.Errorf("game %d should start with %s, got %s", gameNumber, want, got)
+		}
+	}
+}
+
+func TestFirstPlayerFor_RandomPicksBothSides(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	seen := map[boards.Cell]bool{}
+
+	for gameNumber := range 20 {
+		seen[FirstPlayerFor(tttio.RandomFirst, gameNumber, random)] = true
+	}
+
+	if !seen[boards.PlayerX] || !seen[boards.PlayerO] {
+		t.Errorf("random choice should pick both sides over 20 games, got %v", seen)
+	}
+}
+
+func TestPlaySession_AlternatesStartingSide(t *testing.T) {
+	var output bytes.Buffer
+	input := "4\n1\n1\n1\n4\n2\n5\n3\ny\n1\n1\n1\n4\n2\n5\n3\nn\n"
+	reader := bufio.NewReader(strings.NewReader(input))
+
+	PlaySession(reader, &output, testSessionOptions())
+
+	result := output.String()
+	if !strings.Contains(result, "Player X wins") {
+		t.Error("X should win the first game after moving first")
+	}
+
+	if !strings.Contains(result, "Player O wins") {
+		t.Error("O should win the second game after moving first")
+	}
+}
+
+func testSessionOptions() SessionOptions {
+	return SessionOptions{
+		Random:   rand.New(rand.NewSource(1)),
+		Roster:   tttio.DefaultRoster(),
+		Renderer: tttio.PlainRenderer{},
+	}
+}
+
+func TestGame_UsesRosterNamesAndTokens(t *testing.T) {
+	var output bytes.Buffer
+	roster, _ := tttio.NewRoster(
+		tttio.Identity{Name: "Alice", Token: "★"},
+		tttio.Identity{Name: "Bob", Token: "●"})
+	game := NewGame(
+		&scriptedPlayer{moves: []int{1, 2, 3}},
+		&scriptedPlayer{moves: []int{4, 5}},
+		&output,
+		WithRoster(roster))
+
+	game.PlayGame()
+
+	result := output.String()
+	expectedContent := []string{"Alice's turn", "Bob's turn", "Alice wins!", " ★ ", " ● "}
+	for _, content := range expectedContent {
+		if !strings.Contains(result, content) {
+			t.Errorf("output should include %q", content)
+		}
+	}
+
+	if strings.Contains(result, " X ") || strings.Contains(result, " O ") {
+		t.Error("board should show custom tokens instead of X and O")
+	}
+}
+
+func TestGame_ReportsWinningLine(t *testing.T) {
+	var output bytes.Buffer
+	game := NewGame(
+		&scriptedPlayer{moves: []int{1, 5, 9}},
+		&scriptedPlayer{moves: []int{2, 3}},
+		&output)
+
+	result, _ := game.PlayGame()
+
+	if len(result.Lines) != 1 || result.Lines[0] != (boards.Line{1, 5, 9}) {
+		t.Errorf("result should record the 1-5-9 diagonal, got %v", result.Lines)
+	}
+
+	if !strings.Contains(output.String(), "Winning line: 1-5-9") {
+		t.Error("should announce the winning line")
+	}
+}
+
+func TestPlayGameContext_CancelAbortsGame(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	game := NewGame(&scriptedPlayer{moves: []int{1}}, &scriptedPlayer{moves: []int{2}}, io.Discard)
+
+	result, err := game.PlayGameContext(ctx)
+
+	if result.Reason != Aborted || result.Player != boards.PlayerX {
+		t.Errorf("expected X to be blamed for an aborted game, got %+v", result)
+	}
+	if !errors.Is(err, ErrAborted) || !errors.Is(err, context.Canceled) {
+		t.Errorf("expected an aborted, cancelled error, got %v", err)
+	}
+}
+
+func TestBuildGame_PresetKindsSkipMenu(t *testing.T) {
+	var output bytes.Buffer
+	reader := bufio.NewReader(strings.NewReader(""))
+
+	testGame := BuildGame(reader, &output, WithPlayerKinds("rules", players.AIKind))
+
+	if strings.Contains(output.String(), "Select Player") {
+		t.Error("preset kinds should not show the type menu")
+	}
+
+	if _, ok := testGame.playerX.(*players.RulePlayer); !ok {
+		t.Errorf("X should be a rule player, got %T", testGame.playerX)
+	}
+
+	if _, ok := testGame.playerO.(*players.AIPlayer); !ok {
+		t.Errorf("O should be an AI player, got %T", testGame.playerO)
+	}
+}
+
+func TestBuildGame_AsksAgainWhenKindFails(t *testing.T) {
+	var output bytes.Buffer
+	reader := bufio.NewReader(strings.NewReader("3\n"))
+
+	testGame := BuildGame(reader, &output,
+		WithPlayerKinds(players.AIKind, players.AIKind),
+		WithKindOptions(map[string]map[string]string{players.AIKind: {"swindle": "maybe"}}))
+
+	if !strings.Contains(output.String(), "Invalid input") || !strings.Contains(output.String(), "Select Player X type") {
+		t.Error("a kind that cannot be built should be reported and the menu shown")
+	}
+
+	if _, ok := testGame.playerX.(*players.RulePlayer); !ok {
+		t.Errorf("X should be the rule player chosen from the menu, got %T", testGame.playerX)
+	}
+}
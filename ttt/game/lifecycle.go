@@ -0,0 +1,64 @@
+package game
+
+import (
+	"ttt/boards"
+	"ttt/players"
+)
+
+// playerNotifier passes game events on to players that implement the
+// optional lifecycle interfaces. It reads the seats at call time because
+// BuildGame fills them in after NewGame.
+type playerNotifier struct {
+	game *Game
+}
+
+type seat struct {
+	symbol boards.Cell
+	player players.Player
+}
+
+func (notifier *playerNotifier) seats() []seat {
+	return []seat{
+		{boards.PlayerX, notifier.game.playerX},
+		{boards.PlayerO, notifier.game.playerO},
+	}
+}
+
+func (notifier *playerNotifier) OnGameStart(board boards.Board) {
+	for _, seat := range notifier.seats() {
+		if listener, ok := seat.player.(players.GameStartNotifier); ok {
+			listener.GameStarted(seat.symbol, board)
+		}
+	}
+}
+
+func (notifier *playerNotifier) OnTurnStart(player boards.Cell, board boards.Board) {}
+
+func (notifier *playerNotifier) OnMove(mover boards.Cell, position int, board boards.Board) {
+	for _, seat := range notifier.seats() {
+		if listener, ok := seat.player.(players.MoveObserver); ok {
+			listener.MoveMade(mover, position, board)
+		}
+	}
+}
+
+func (notifier *playerNotifier) OnInvalidMove(player boards.Cell, position int, err error) {}
+
+// OnGameEnd tells a player whose move was abandoned only once that move
+// returns, so the two never touch the player's state at once. The game does
+// not wait for it: a player stuck in ReadMove hears nothing.
+func (notifier *playerNotifier) OnGameEnd(result GameResult, board boards.Board) {
+	for _, seat := range notifier.seats() {
+		listener, ok := seat.player.(players.GameEndNotifier)
+		if !ok {
+			continue
+		}
+
+		notify := func() { listener.GameEnded(result.Status, board) }
+		if settler, ok := notifier.game.adapters[seat.symbol].(players.Settler); ok {
+			settler.AfterSettled(notify)
+		} else {
+			notify()
+		}
+	}
+}
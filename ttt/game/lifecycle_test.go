@@ -0,0 +1,77 @@
+package game
+
+import (
+	"fmt"
+	"io"
+	"math/rand"
+	"slices"
+	"testing"
+	"ttt/boards"
+	"ttt/learning"
+	"ttt/players"
+)
+
+type lifecyclePlayer struct {
+	scriptedPlayer
+	events []string
+}
+
+func (player *lifecyclePlayer) GameStarted(symbol boards.Cell, board boards.Board) {
+	player.events = append(player.events, "start "+symbol.String())
+}
+
+func (player *lifecyclePlayer) MoveMade(mover boards.Cell, position int, board boards.Board) {
+	player.events = append(player.events, fmt.Sprintf("move %s %d", mover, position))
+}
+
+func (player *lifecyclePlayer) GameEnded(status boards.GameStatus, board boards.Board) {
+	player.events = append(player.events, fmt.Sprintf("end %d", status))
+}
+
+func TestLifecycle_PlayersHearStartMovesAndEnd(t *testing.T) {
+	playerO := &lifecyclePlayer{scriptedPlayer: scriptedPlayer{moves: []int{4, 5}}}
+	game := NewGame(&scriptedPlayer{moves: []int{1, 2, 3}}, playerO, io.Discard)
+
+	game.PlayGame()
+
+	expected := []string{
+		"start O",
+		"move X 1", "move O 4",
+		"move X 2", "move O 5",
+		"move X 3",
+		fmt.Sprintf("end %d", boards.XWins),
+	}
+	if !slices.Equal(playerO.events, expected) {
+		t.Errorf("expected %v, got %v", expected, playerO.events)
+	}
+}
+
+func TestLifecycle_AbortedGameEndsInProgress(t *testing.T) {
+	playerX := &lifecyclePlayer{scriptedPlayer: scriptedPlayer{moves: []int{1}}}
+	game := NewGame(playerX, &scriptedPlayer{}, io.Discard)
+
+	game.PlayGame()
+
+	if last := playerX.events[len(playerX.events)-1]; last != fmt.Sprintf("end %d", boards.InProgress) {
+		t.Errorf("an aborted game should end in progress, got %q", last)
+	}
+}
+
+func TestLifecycle_LearningPlayerLearnsFromGame(t *testing.T) {
+	table := learning.NewTable()
+	learner := players.NewLearningPlayer(boards.PlayerO, table, rand.New(rand.NewSource(1)))
+	game := NewGame(&scriptedPlayer{moves: []int{5, 1, 9, 3, 7}}, learner, io.Discard,
+		WithIllegalMovePolicy(ForfeitPolicy()))
+
+	game.PlayGame()
+
+	var rewarded bool
+	for _, beads := range table.Lookup(boards.Board{{}, {boards.Empty, boards.PlayerO, boards.Empty}}) {
+		if beads != 0 && beads != learning.InitialBeads {
+			rewarded = true
+		}
+	}
+	if !rewarded {
+		t.Error("the learner should update its opening box when the game ends")
+	}
+}
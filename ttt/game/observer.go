@@ -0,0 +1,80 @@
+package game
+
+import (
+	"io"
+	"ttt/boards"
+	tttio "ttt/io"
+)
+
+type GameObserver interface {
+	OnGameStart(board boards.Board)
+	OnTurnStart(player boards.Cell, board boards.Board)
+	OnMove(player boards.Cell, position int, board boards.Board)
+	OnInvalidMove(player boards.Cell, position int, err error)
+	OnGameEnd(result GameResult, board boards.Board)
+}
+
+type ConsoleObserver struct {
+	output   io.Writer
+	roster   tttio.Roster
+	renderer tttio.Renderer
+	clock    *Clock
+}
+
+func NewConsoleObserver(output io.Writer, roster tttio.Roster, renderer tttio.Renderer) *ConsoleObserver {
+	return &ConsoleObserver{
+		output:   output,
+		roster:   roster,
+		renderer: renderer,
+	}
+}
+
+func (observer *ConsoleObserver) OnGameStart(board boards.Board) {
+	tttio.ShowWelcome(observer.output)
+	tttio.ShowBoardView(observer.output, observer.renderer, tttio.BoardView{
+		Board:  board,
+		Roster: observer.roster,
+	})
+}
+
+func (observer *ConsoleObserver) OnTurnStart(player boards.Cell, board boards.Board) {
+	if observer.clock != nil {
+		tttio.ShowPlayerTurnWithClock(observer.output, observer.roster.For(player), observer.clock.Budget(player))
+		return
+	}
+	tttio.ShowPlayerTurn(observer.output, observer.roster.For(player))
+}
+
+func (observer *ConsoleObserver) OnMove(player boards.Cell, position int, board boards.Board) {
+	_, lines := board.WinningLines()
+	tttio.ShowBoardView(observer.output, observer.renderer, tttio.BoardView{
+		Board:    board,
+		Roster:   observer.roster,
+		LastMove: position,
+		Lines:    lines,
+	})
+}
+
+func (observer *ConsoleObserver) OnInvalidMove(player boards.Cell, position int, err error) {
+	tttio.ShowIllegalMove(observer.output, observer.roster.For(player), position, err)
+}
+
+func (observer *ConsoleObserver) OnGameEnd(result GameResult, board boards.Board) {
+	switch result.Reason {
+	case Forfeited:
+		tttio.ShowForfeit(observer.output, observer.roster.For(result.Player))
+	case IllegalMoveForfeit:
+		tttio.ShowIllegalMoveForfeit(observer.output, observer.roster.For(result.Player))
+	case OutOfTime:
+		tttio.ShowOutOfTime(observer.output, observer.roster.For(result.Player))
+	}
+
+	switch result.Status {
+	case boards.XWins:
+		tttio.ShowWinner(observer.output, observer.roster.X, result.Lines...)
+	case boards.OWins:
+		tttio.ShowWinner(observer.output, observer.roster.O, result.Lines...)
+	case boards.Draw:
+		tttio.ShowDraw(observer.output)
+	}
+}
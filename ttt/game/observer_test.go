@@ -0,0 +1,114 @@
+package game
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+	"ttt/boards"
+	"ttt/players"
+)
+
+type recordingObserver struct {
+	events []string
+}
+
+func (observer *recordingObserver) OnGameStart(board boards.Board) {
+	observer.events = append(observer.events, "start")
+}
+
+func (observer *recordingObserver) OnTurnStart(player boards.Cell, board boards.Board) {
+	observer.events = append(observer.events, "turn "+player.String())
+}
+
+func (observer *recordingObserver) OnMove(player boards.Cell, position int, board boards.Board) {
+	observer.events = append(observer.events, fmt.Sprintf("move %s %d", player, position))
+}
+
+func (observer *recordingObserver) OnInvalidMove(player boards.Cell, position int, err error) {
+	observer.events = append(observer.events, fmt.Sprintf("invalid %s %d", player, position))
+}
+
+func (observer *recordingObserver) OnGameEnd(result GameResult, board boards.Board) {
+	observer.events = append(observer.events, fmt.Sprintf("end %d %s", result.Status, result.Reason))
+}
+
+type scriptedPlayer struct {
+	moves []int
+}
+
+func (player *scriptedPlayer) ReadMove(board boards.Board) (int, error) {
+	if len(player.moves) == 0 {
+		return 0, io.EOF
+	}
+	move := player.moves[0]
+	player.moves = player.moves[1:]
+	return move, nil
+}
+
+func TestObserver_ReceivesEventsInOrder(t *testing.T) {
+	observer := &recordingObserver{}
+	game := NewGame(
+		&scriptedPlayer{moves: []int{1, 2, 3}},
+		&scriptedPlayer{moves: []int{4, 5}},
+		io.Discard)
+	game.AddObserver(observer)
+
+	game.PlayGame()
+
+	expected := []string{
+		"start",
+		"turn X", "move X 1",
+		"turn O", "move O 4",
+		"turn X", "move X 2",
+		"turn O", "move O 5",
+		"turn X", "move X 3",
+		fmt.Sprintf("end %d completed", boards.XWins),
+	}
+
+	if strings.Join(observer.events, ",") != strings.Join(expected, ",") {
+		t.Errorf("events = %v, want %v", observer.events, expected)
+	}
+}
+
+func TestObserver_ReceivesInvalidMove(t *testing.T) {
+	observer := &recordingObserver{}
+	game := NewGame(
+		&scriptedPlayer{moves: []int{5}},
+		&scriptedPlayer{moves: []int{5}},
+		io.Discard)
+	game.AddObserver(observer)
+
+	game.PlayGame()
+
+	invalid := observer.events[len(observer.events)-2]
+	if invalid != "invalid O 5" {
+		t.Errorf("should report O's invalid move, got %q", invalid)
+	}
+
+	last := observer.events[len(observer.events)-1]
+	if last != fmt.Sprintf("end %d illegal move", boards.InProgress) {
+		t.Errorf("last event should end the game on the illegal move, got %q", last)
+	}
+}
+
+func TestObserver_ConsoleObserverWritesGameOutput(t *testing.T) {
+	var output bytes.Buffer
+	reader := bufio.NewReader(strings.NewReader("1\n4\n2\n5\n3\n"))
+	game := NewGame(
+		players.NewHumanPlayer(reader, io.Discard),
+		players.NewHumanPlayer(reader, io.Discard),
+		&output)
+
+	game.PlayGame()
+
+	result := output.String()
+	expectedContent := []string{"Welcome", "Player X's turn", "Player O's turn", "Player X wins"}
+	for _, content := range expectedContent {
+		if !strings.Contains(result, content) {
+			t.Errorf("console output should include %q", content)
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package game
+
+import (
+	"math/rand"
+	"ttt/boards"
+	tttio "ttt/io"
+)
+
+type Option func(*Game)
+
+func WithIllegalMovePolicy(policy IllegalMovePolicy) Option {
+	return func(game *Game) {
+		game.illegalMovePolicy = policy
+	}
+}
+
+func WithFirstPlayer(symbol boards.Cell) Option {
+	return func(game *Game) {
+		game.firstPlayer = symbol
+	}
+}
+
+func WithRoster(roster tttio.Roster) Option {
+	return func(game *Game) {
+		game.roster = roster
+	}
+}
+
+func WithRenderer(renderer tttio.Renderer) Option {
+	return func(game *Game) {
+		game.renderer = renderer
+	}
+}
+
+// WithRandom lets AI players built by BuildGame vary between equally good
+// moves.
+func WithRandom(random *rand.Rand) Option {
+	return func(game *Game) {
+		game.random = random
+	}
+}
+
+func WithTimeControl(control TimeControl) Option {
+	return func(game *Game) {
+		game.clock = nil
+		if control.Enabled() {
+			game.clock = NewClock(control)
+		}
+	}
+}
+
+// WithPlayerKinds names the registered kinds BuildGame seats as X and O. An
+// empty name asks with the player type menu.
+func WithPlayerKinds(playerX string, playerO string) Option {
+	return func(game *Game) {
+		game.playerKinds = map[boards.Cell]string{boards.PlayerX: playerX, boards.PlayerO: playerO}
+	}
+}
+
+// WithKindOptions passes settings to the players BuildGame creates, keyed by
+// kind name and then option name.
+func WithKindOptions(options map[string]map[string]string) Option {
+	return func(game *Game) {
+		game.kindOptions = options
+	}
+}
@@ -0,0 +1,39 @@
+package game
+
+import (
+	"math/rand"
+)
+
+type IllegalMoveRule int
+
+const (
+	StopOnIllegalMove IllegalMoveRule = iota
+	ForfeitOnIllegalMove
+	RetryIllegalMove
+	SubstituteRandomMove
+)
+
+type IllegalMovePolicy struct {
+	Rule    IllegalMoveRule
+	Retries int
+	Random  *rand.Rand
+}
+
+func ForfeitPolicy() IllegalMovePolicy {
+	return IllegalMovePolicy{Rule: ForfeitOnIllegalMove}
+}
+
+func RetryPolicy(retries int) IllegalMovePolicy {
+	return IllegalMovePolicy{Rule: RetryIllegalMove, Retries: retries}
+}
+
+func RandomMovePolicy(random *rand.Rand) IllegalMovePolicy {
+	return IllegalMovePolicy{Rule: SubstituteRandomMove, Random: random}
+}
+
+func (policy IllegalMovePolicy) randomMove(moves []int) int {
+	if policy.Random == nil {
+		return moves[rand.Intn(len(moves))]
+	}
+	return moves[policy.Random.Intn(len(moves))]
+}
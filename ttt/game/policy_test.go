@@ -0,0 +1,137 @@
+package game
+
+import (
+	"bytes"
+	"io"
+	"math/rand"
+	"strings"
+	"testing"
+	"ttt/boards"
+)
+
+func TestPolicy_DefaultStopsOnIllegalMove(t *testing.T) {
+	game := NewGame(
+		&scriptedPlayer{moves: []int{5}},
+		&scriptedPlayer{moves: []int{5}},
+		io.Discard)
+
+	result, err := game.PlayGame()
+
+	if err == nil {
+		t.Error("default policy should return an error")
+	}
+
+	if result.Reason != IllegalMove {
+		t.Errorf("should end with IllegalMove, got %v", result.Reason)
+	}
+}
+
+func TestPolicy_ForfeitOnFirstIllegalMove(t *testing.T) {
+	var output bytes.Buffer
+	game := NewGame(
+		&scriptedPlayer{moves: []int{5}},
+		&scriptedPlayer{moves: []int{5, 1}},
+		&output,
+		WithIllegalMovePolicy(ForfeitPolicy()))
+
+	result, err := game.PlayGame()
+
+	if err != nil {
+		t.Fatalf("forfeit should not return an error: %v", err)
+	}
+
+	if result.Reason != IllegalMoveForfeit || result.Status != boards.XWins || result.Player != boards.PlayerO {
+		t.Errorf("O should forfeit to X, got %+v", result)
+	}
+
+	if !strings.Contains(output.String(), "forfeits after an illegal move") {
+		t.Error("should announce the illegal-move forfeit")
+	}
+}
+
+func TestPolicy_RetryAllowsCorrection(t *testing.T) {
+	game := NewGame(
+		&scriptedPlayer{moves: []int{1, 2, 3}},
+		&scriptedPlayer{moves: []int{1, 4, 5}},
+		io.Discard,
+		WithIllegalMovePolicy(RetryPolicy(1)))
+
+	result, err := game.PlayGame()
+
+	if err != nil {
+		t.Fatalf("corrected move should not return an error: %v", err)
+	}
+
+	if result.Reason != Completed || result.Status != boards.XWins {
+		t.Errorf("game should complete after the retry, got %+v", result)
+	}
+}
+
+func TestPolicy_RetryForfeitsWhenExhausted(t *testing.T) {
+	game := NewGame(
+		&scriptedPlayer{moves: []int{1}},
+		&scriptedPlayer{moves: []int{1, 1, 1}},
+		io.Discard,
+		WithIllegalMovePolicy(RetryPolicy(2)))
+
+	result, _ := game.PlayGame()
+
+	if result.Reason != IllegalMoveForfeit || result.Status != boards.XWins {
+		t.Errorf("O should forfeit after exhausting retries, got %+v", result)
+	}
+}
+
+// boardCheckingObserver records moves whose position does not hold the
+// mover's mark on the board it is shown.
+type boardCheckingObserver struct {
+	recordingObserver
+	mismatches []int
+}
+
+func (observer *boardCheckingObserver) OnMove(player boards.Cell, position int, board boards.Board) {
+	observer.recordingObserver.OnMove(player, position, board)
+	if position < boards.MinPosition || position > boards.MaxPosition || board.At(position) != player {
+		observer.mismatches = append(observer.mismatches, position)
+	}
+}
+
+func TestPolicy_SubstitutesRandomLegalMove(t *testing.T) {
+	observer := &boardCheckingObserver{}
+	game := NewGame(
+		&scriptedPlayer{moves: []int{1, 2, 3, 4, 5, 6, 7, 8, 9}},
+		&scriptedPlayer{moves: []int{1, 1, 1, 1}},
+		io.Discard,
+		WithIllegalMovePolicy(RandomMovePolicy(rand.New(rand.NewSource(1)))))
+	game.AddObserver(observer)
+
+	result, _ := game.PlayGame()
+
+	if result.Reason == IllegalMove || result.Reason == IllegalMoveForfeit {
+		t.Errorf("random substitution should keep the game going, got %+v", result)
+	}
+
+	moves, substituted := 0, 0
+	for _, event := range observer.events {
+		if event == "move O 1" {
+			t.Error("observers should be told the substituted move, not the illegal one")
+		}
+		if strings.HasPrefix(event, "move O") {
+			moves++
+		}
+		if strings.HasPrefix(event, "invalid") {
+			substituted++
+		}
+	}
+
+	if moves == 0 {
+		t.Error("O should have substituted moves placed on the board")
+	}
+
+	if len(observer.mismatches) > 0 {
+		t.Errorf("reported moves should be on the board, got %v", observer.mismatches)
+	}
+
+	if result.Substituted != substituted {
+		t.Errorf("result should count %d substitutions, got %d", substituted, result.Substituted)
+	}
+}
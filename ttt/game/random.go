@@ -0,0 +1,31 @@
+package game
+
+import (
+	"math/rand"
+	"sync"
+)
+
+// lockedSource lets one random source be shared by players whose abandoned
+// moves may still be running; see players.AdaptContext.
+type lockedSource struct {
+	mutex  sync.Mutex
+	source rand.Source
+}
+
+func (locked *lockedSource) Int63() int64 {
+	locked.mutex.Lock()
+	defer locked.mutex.Unlock()
+	return locked.source.Int63()
+}
+
+func (locked *lockedSource) Seed(seed int64) {
+	locked.mutex.Lock()
+	defer locked.mutex.Unlock()
+	locked.source.Seed(seed)
+}
+
+// NewRandom returns a random source that is safe for concurrent use, for
+// sharing across a session.
+func NewRandom(seed int64) *rand.Rand {
+	return rand.New(&lockedSource{source: rand.NewSource(seed)})
+}
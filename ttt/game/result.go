@@ -0,0 +1,31 @@
+package game
+
+import (
+	"errors"
+	"ttt/engine"
+)
+
+// The engine owns end reasons; this name keeps the game package's API.
+type EndReason = engine.EndReason
+
+// GameResult is the engine's result plus what only the driver knows.
+type GameResult struct {
+	engine.Result
+	Substituted int // illegal moves replaced with random legal ones
+}
+
+const (
+	Completed          = engine.Completed
+	Aborted            = engine.Aborted
+	Forfeited          = engine.Forfeited
+	IllegalMove        = engine.IllegalMove
+	IllegalMoveForfeit = engine.IllegalMoveForfeit
+	OutOfTime          = engine.OutOfTime
+)
+
+var (
+	ErrAborted     = errors.New("game aborted")
+	ErrIllegalMove = errors.New("illegal move")
+
+	errOutOfTime = errors.New("out of time")
+)
@@ -0,0 +1,124 @@
+package game
+
+import (
+	"io"
+	"math/rand"
+	"ttt/boards"
+	"ttt/learning"
+	"ttt/players"
+)
+
+type TrainingOptions struct {
+	Games     int
+	BatchSize int
+	Random    *rand.Rand
+	Report    func(BatchResult)
+}
+
+// BatchResult counts the learner's results over one batch of games.
+type BatchResult struct {
+	Batch  int
+	Wins   int
+	Draws  int
+	Losses int
+}
+
+// Opponent builds the learner's opponent for one game, playing symbol.
+type Opponent func(symbol boards.Cell) players.Player
+
+// cachedAIPlayer plays like an AIPlayer with random tie-breaking, but
+// remembers the best moves of every position it has searched: training
+// replays the same positions thousands of times.
+type cachedAIPlayer struct {
+	ai     *players.AIPlayer
+	cache  map[boards.Index][]int
+	random *rand.Rand
+}
+
+func (cached *cachedAIPlayer) ReadMove(board boards.Board) (int, error) {
+	bestMoves, ok := cached.cache[board.Index()]
+	if !ok {
+		bestMoves, _ = cached.ai.BestMoves(board)
+		cached.cache[board.Index()] = bestMoves
+	}
+
+	if len(bestMoves) == 0 {
+		return 0, players.ErrNoMoves
+	}
+	return bestMoves[cached.random.Intn(len(bestMoves))], nil
+}
+
+func AIOpponent(random *rand.Rand) Opponent {
+	caches := map[boards.Cell]map[boards.Index][]int{
+		boards.PlayerX: {},
+		boards.PlayerO: {},
+	}
+
+	return func(symbol boards.Cell) players.Player {
+		return &cachedAIPlayer{
+			ai:     players.NewAIPlayer(symbol, symbol.Opponent()),
+			cache:  caches[symbol],
+			random: random,
+		}
+	}
+}
+
+// SelfPlayOpponent plays from the same table, so both sides learn.
+func SelfPlayOpponent(table *learning.Table, random *rand.Rand) Opponent {
+	return func(symbol boards.Cell) players.Player {
+		return players.NewLearningPlayer(symbol, table, random)
+	}
+}
+
+func (batch *BatchResult) record(outcome learning.Outcome) {
+	switch outcome {
+	case learning.Won:
+		batch.Wins++
+	case learning.Drew:
+		batch.Draws++
+	default:
+		batch.Losses++
+	}
+}
+
+// Train plays options.Games games between a LearningPlayer on table and
+// opponent, switching sides every game with X moving first, and reports
+// the learner's results after every batch. Learning players update the
+// table when the game tells them it has ended.
+func Train(table *learning.Table, opponent Opponent, options TrainingOptions) []BatchResult {
+	batchSize := options.BatchSize
+	if batchSize <= 0 {
+		batchSize = options.Games
+	}
+
+	var results []BatchResult
+	batch := BatchResult{Batch: 1}
+
+	for gameNumber := range options.Games {
+		side := boards.PlayerX
+		if gameNumber%2 == 1 {
+			side = boards.PlayerO
+		}
+
+		learner := players.NewLearningPlayer(side, table, options.Random)
+		rival := opponent(side.Opponent())
+
+		playerX, playerO := players.Player(learner), rival
+		if side == boards.PlayerO {
+			playerX, playerO = rival, learner
+		}
+
+		result, _ := NewGame(playerX, playerO, io.Discard).PlayGame()
+		batch.record(learning.OutcomeFor(result.Status, side))
+
+		if (gameNumber+1)%batchSize == 0 || gameNumber == options.Games-1 {
+			results = append(results, batch)
+			if options.Report != nil {
+				options.Report(batch)
+			}
+			batch = BatchResult{Batch: batch.Batch + 1}
+		}
+	}
+
+	return results
+}
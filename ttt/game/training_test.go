@@ -0,0 +1,70 @@
+package game
+
+import (
+	"math/rand"
+	"testing"
+	"ttt/learning"
+)
+
+func TestTrain_ReportsEveryBatch(t *testing.T) {
+	table := learning.NewTable()
+	var reported []BatchResult
+
+	results := Train(table, AIOpponent(rand.New(rand.NewSource(1))), TrainingOptions{
+		Games:     25,
+		BatchSize: 10,
+		Random:    rand.New(rand.NewSource(2)),
+		Report: func(batch BatchResult) {
+			reported = append(reported, batch)
+		},
+	})
+
+	if len(results) != 3 || len(reported) != 3 {
+		t.Fatalf("expected 3 batches (10, 10, 5), got %d results and %d reports", len(results), len(reported))
+	}
+
+	total := 0
+	for index, batch := range results {
+		if batch.Batch != index+1 {
+			t.Errorf("batch %d numbered %d", index+1, batch.Batch)
+		}
+		if batch.Wins != 0 {
+			t.Errorf("batch %d: nobody beats the AI, got %d wins", batch.Batch, batch.Wins)
+		}
+		total += batch.Wins + batch.Draws + batch.Losses
+	}
+	if total != 25 {
+		t.Errorf("expected 25 games across batches, got %d", total)
+	}
+
+	if table.Len() == 0 {
+		t.Error("training should fill the table")
+	}
+}
+
+func TestTrain_LearnerImprovesAgainstAI(t *testing.T) {
+	results := Train(learning.NewTable(), AIOpponent(rand.New(rand.NewSource(3))), TrainingOptions{
+		Games:     4000,
+		BatchSize: 500,
+		Random:    rand.New(rand.NewSource(4)),
+	})
+
+	first, last := results[0], results[len(results)-1]
+	if last.Losses >= first.Losses/2 {
+		t.Errorf("expected losses to at least halve, first batch %+v, last batch %+v", first, last)
+	}
+}
+
+func TestTrain_SelfPlay(t *testing.T) {
+	table := learning.NewTable()
+	random := rand.New(rand.NewSource(5))
+
+	results := Train(table, SelfPlayOpponent(table, random), TrainingOptions{Games: 100, Random: random})
+
+	if len(results) != 1 {
+		t.Fatalf("a zero batch size should report once, got %d batches", len(results))
+	}
+	if batch := results[0]; batch.Wins+batch.Draws+batch.Losses != 100 {
+		t.Errorf("expected 100 games, got %+v", batch)
+	}
+}
@@ -0,0 +1,84 @@
+package io
+
+import (
+	"errors"
+	"strings"
+	"ttt/boards"
+	"unicode"
+	"unicode/utf8"
+)
+
+type Identity struct {
+	Name  string
+	Token string
+}
+
+type Roster struct {
+	X Identity
+	O Identity
+}
+
+var (
+	ErrEmptyName    = errors.New("Name cannot be empty")
+	ErrInvalidToken = errors.New("Token must be a single non-digit character")
+	ErrSameToken    = errors.New("Players must use different tokens")
+)
+
+func NewIdentity(name string, token string) (Identity, error) {
+	name = strings.TrimSpace(name)
+	if name == EmptyInput {
+		return Identity{}, ErrEmptyName
+	}
+
+	if !singleGrapheme(token) {
+		return Identity{}, ErrInvalidToken
+	}
+
+	if symbol, _ := utf8.DecodeRuneInString(token); unicode.IsSpace(symbol) || unicode.IsDigit(symbol) {
+		return Identity{}, ErrInvalidToken
+	}
+
+	return Identity{Name: name, Token: token}, nil
+}
+
+func DefaultIdentity(symbol boards.Cell) Identity {
+	return Identity{Name: "Player " + symbol.String(), Token: symbol.String()}
+}
+
+func DefaultRoster() Roster {
+	return Roster{
+		X: DefaultIdentity(boards.PlayerX),
+		O: DefaultIdentity(boards.PlayerO),
+	}
+}
+
+func NewRoster(x Identity, o Identity) (Roster, error) {
+	if x.Token == o.Token {
+		return Roster{}, ErrSameToken
+	}
+	return Roster{X: x, O: o}, nil
+}
+
+func (roster Roster) For(symbol boards.Cell) Identity {
+	if symbol == boards.PlayerO {
+		return roster.O
+	}
+	return roster.X
+}
+
+func (roster Roster) tokenFor(cell boards.Cell) string {
+	switch cell {
+	case boards.PlayerX:
+		return roster.X.Token
+	case boards.PlayerO:
+		return roster.O.Token
+	default:
+		return emptyToken
+	}
+}
+
+// cellWidth is the columns every cell takes so that wide tokens keep the
+// grid aligned.
+func (roster Roster) cellWidth() int {
+	return max(1, displayWidth(roster.X.Token), displayWidth(roster.O.Token))
+}
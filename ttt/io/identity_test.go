@@ -0,0 +1,126 @@
+package io
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"ttt/boards"
+)
+
+func TestNewIdentity_AcceptsNameAndToken(t *testing.T) {
+	identity, err := NewIdentity("Alice", "A")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if identity.Name != "Alice" || identity.Token != "A" {
+		t.Errorf("got %+v", identity)
+	}
+}
+
+func TestNewIdentity_AcceptsEmojiToken(t *testing.T) {
+	_, err := NewIdentity("Cat", "🐱")
+
+	if err != nil {
+		t.Errorf("should accept a single emoji: %v", err)
+	}
+}
+
+func TestNewIdentity_AcceptsMultiCodePointEmoji(t *testing.T) {
+	for _, token := range []string{"\u2764\ufe0f", "\U0001f44d\U0001f3fd", "\U0001f1f3\U0001f1f4", "\U0001f469\u200d\U0001f4bb", "e\u0301"} {
+		if _, err := NewIdentity("Alice", token); err != nil {
+			t.Errorf("should accept %q as one character: %v", token, err)
+		}
+	}
+}
+
+func TestNewIdentity_RejectsEmptyName(t *testing.T) {
+	_, err := NewIdentity("  ", "A")
+
+	if err != ErrEmptyName {
+		t.Errorf("should reject empty name, got %v", err)
+	}
+}
+
+func TestNewIdentity_RejectsInvalidTokens(t *testing.T) {
+	for _, token := range []string{"", "AB", " ", "5", "\U0001f44d\U0001f44d", "\u200dA", "\U0001f1f3\U0001f1f4\U0001f1f3"} {
+		_, err := NewIdentity("Alice", token)
+
+		if err != ErrInvalidToken {
+			t.Errorf("should reject token %q, got %v", token, err)
+		}
+	}
+}
+
+func TestNewRoster_RejectsSameToken(t *testing.T) {
+	_, err := NewRoster(Identity{Name: "A", Token: "Z"}, Identity{Name: "B", Token: "Z"})
+
+	if err != ErrSameToken {
+		t.Errorf("should reject duplicate tokens, got %v", err)
+	}
+}
+
+func TestRoster_ForSymbol(t *testing.T) {
+	roster := DefaultRoster()
+
+	if roster.For(boards.PlayerX).Name != "Player X" {
+		t.Error("X should default to Player X")
+	}
+
+	if roster.For(boards.PlayerO).Token != "O" {
+		t.Error("O should default to token O")
+	}
+}
+
+func TestShowBoard_UsesRosterTokens(t *testing.T) {
+	var output bytes.Buffer
+	roster := Roster{X: Identity{Name: "Alice", Token: "★"}, O: Identity{Name: "Bob", Token: "●"}}
+	board := boards.Board{
+		{boards.PlayerX, boards.Empty, boards.PlayerO},
+		{boards.Empty, boards.Empty, boards.Empty},
+		{boards.Empty, boards.Empty, boards.Empty},
+	}
+
+	ShowBoard(&output, board, roster)
+
+	result := output.String()
+	if !strings.Contains(result, " ★ ") || !strings.Contains(result, " ● ") {
+		t.Error("should display roster tokens")
+	}
+
+	if strings.Contains(result, "X") || strings.Contains(result, "O") {
+		t.Error("should not display internal symbols")
+	}
+}
+
+func TestShowWinner_UsesName(t *testing.T) {
+	var output bytes.Buffer
+
+	ShowWinner(&output, Identity{Name: "Alice", Token: "A"})
+
+	if !strings.Contains(output.String(), "Alice wins") {
+		t.Error("should announce winner by name")
+	}
+}
+
+func TestShowBoard_AlignsWideTokens(t *testing.T) {
+	var output bytes.Buffer
+	roster, _ := NewRoster(Identity{Name: "Cat", Token: "\U0001f431"}, Identity{Name: "Dog", Token: "D"})
+	board := boards.NewBoard()
+	board.MakeMove(1, boards.PlayerX)
+	board.MakeMove(5, boards.PlayerO)
+
+	ShowBoard(&output, board, roster)
+
+	lines := strings.Split(strings.Trim(output.String(), "\n"), "\n")
+	for _, line := range lines {
+		width := 0
+		for _, symbol := range line {
+			width += displayWidth(string(symbol))
+		}
+		if width != 14 {
+			t.Errorf("every line should take 14 columns, %q takes %d", line, width)
+		}
+	}
+}
@@ -3,13 +3,17 @@ package io
 import (
 	"bufio"
 	"errors"
+	"fmt"
 	"io"
+	"strconv"
 	"strings"
 )
 
 const (
-	HumanChoice = "1"
-	AIChoice    = "2"
+	FirstX      = "1"
+	FirstO      = "2"
+	FirstRandom = "3"
+	FirstAlt    = "4"
 	YesShort    = "y"
 	YesLong     = "yes"
 	NoShort     = "n"
@@ -17,45 +21,58 @@ const (
 	EmptyInput  = ""
 )
 
-type PlayerType int
+// MenuItem is one entry in a numbered menu.
+type MenuItem struct {
+	Title       string
+	Description string
+}
+
+type FirstPlayerChoice int
 
 const (
-	Human PlayerType = iota
-	AI
+	XFirst FirstPlayerChoice = iota
+	OFirst
+	RandomFirst
+	AlternateFirst
 )
 
-func ReadPlayerType(reader *bufio.Reader, output io.Writer) (PlayerType, error) {
+// ReadPlayerType returns the index of the chosen item, which may be picked by
+// number or by title.
+func ReadPlayerType(reader *bufio.Reader, output io.Writer, items []MenuItem) (int, error) {
 	for {
 		line, err := reader.ReadString('\n')
 		if err != nil {
-			return Human, err
+			return 0, err
 		}
 
-		playerType, err := parsePlayerType(line)
+		choice, err := parseMenuChoice(line, items)
 		if err != nil {
 			ShowInvalidInput(output, err)
 			continue
 		}
 
-		return playerType, nil
+		return choice, nil
 	}
 }
 
-func parsePlayerType(input string) (PlayerType, error) {
+func parseMenuChoice(input string, items []MenuItem) (int, error) {
 	input = strings.TrimSpace(input)
 
 	if input == EmptyInput {
-		return Human, errors.New("Input cannot be empty")
+		return 0, errors.New("Input cannot be empty")
 	}
 
-	switch input {
-	case HumanChoice:
-		return Human, nil
-	case AIChoice:
-		return AI, nil
-	default:
-		return Human, errors.New("Invalid choice. Enter 1 for Human or 2 for AI")
+	if number, err := strconv.Atoi(input); err == nil && number >= 1 && number <= len(items) {
+		return number - 1, nil
 	}
+
+	for index, item := range items {
+		if strings.EqualFold(input, item.Title) {
+			return index, nil
+		}
+	}
+
+	return 0, fmt.Errorf("Invalid choice. Enter a number from 1 to %d", len(items))
 }
 
 func ReadPlayAgain(reader *bufio.Reader, output io.Writer) (bool, error) {
@@ -91,3 +108,41 @@ func parsePlayAgain(input string) (bool, error) {
 		return false, errors.New("Invalid input. Enter 'y' for yes or 'n' for no")
 	}
 }
+
+func ReadFirstPlayer(reader *bufio.Reader, output io.Writer) (FirstPlayerChoice, error) {
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			return XFirst, err
+		}
+
+		choice, err := parseFirstPlayer(line)
+		if err != nil {
+			ShowInvalidInput(output, err)
+			continue
+		}
+
+		return choice, nil
+	}
+}
+
+func parseFirstPlayer(input string) (FirstPlayerChoice, error) {
+	input = strings.TrimSpace(input)
+
+	if input == EmptyInput {
+		return XFirst, errors.New("Input cannot be empty")
+	}
+
+	switch input {
+	case FirstX:
+		return XFirst, nil
+	case FirstO:
+		return OFirst, nil
+	case FirstRandom:
+		return RandomFirst, nil
+	case FirstAlt:
+		return AlternateFirst, nil
+	default:
+		return XFirst, errors.New("Invalid choice. Enter a number from 1 to 4")
+	}
+}
@@ -5,19 +5,27 @@ import (
 	"bytes"
 	"strings"
 	"testing"
+	"ttt/boards"
 )
 
+const (
+	testHuman = iota
+	testAI
+)
+
+var testPlayerTypes = []MenuItem{{Title: "Human"}, {Title: "AI"}}
+
 func TestReadPlayerType_SelectsHumanWith1(t *testing.T) {
 	var output bytes.Buffer
 	reader := bufio.NewReader(strings.NewReader("1\n"))
 
-	playerType, err := ReadPlayerType(reader, &output)
+	playerType, err := ReadPlayerType(reader, &output, testPlayerTypes)
 
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
 
-	if playerType != Human {
+	if playerType != testHuman {
 		t.Errorf("input '1' should select Human, got %v", playerType)
 	}
 }
@@ -26,13 +34,13 @@ func TestReadPlayerType_SelectsAIWith2(t *testing.T) {
 	var output bytes.Buffer
 	reader := bufio.NewReader(strings.NewReader("2\n"))
 
-	playerType, err := ReadPlayerType(reader, &output)
+	playerType, err := ReadPlayerType(reader, &output, testPlayerTypes)
 
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
 
-	if playerType != AI {
+	if playerType != testAI {
 		t.Errorf("input '2' should select AI, got %v", playerType)
 	}
 }
@@ -41,13 +49,13 @@ func TestReadPlayerType_RetriesAfterInvalidNumber(t *testing.T) {
 	var output bytes.Buffer
 	reader := bufio.NewReader(strings.NewReader("3\n1\n"))
 
-	playerType, err := ReadPlayerType(reader, &output)
+	playerType, err := ReadPlayerType(reader, &output, testPlayerTypes)
 
 	if err != nil {
 		t.Fatalf("should eventually succeed: %v", err)
 	}
 
-	if playerType != Human {
+	if playerType != testHuman {
 		t.Errorf("should accept '1' after retry, got %v", playerType)
 	}
 
@@ -61,13 +69,13 @@ func TestReadPlayerType_RetriesAfterText(t *testing.T) {
 	var output bytes.Buffer
 	reader := bufio.NewReader(strings.NewReader("abc\n2\n"))
 
-	playerType, err := ReadPlayerType(reader, &output)
+	playerType, err := ReadPlayerType(reader, &output, testPlayerTypes)
 
 	if err != nil {
 		t.Fatalf("should eventually succeed: %v", err)
 	}
 
-	if playerType != AI {
+	if playerType != testAI {
 		t.Errorf("should accept '2' after retry, got %v", playerType)
 	}
 
@@ -81,13 +89,13 @@ func TestReadPlayerType_RetriesAfterEmptyInput(t *testing.T) {
 	var output bytes.Buffer
 	reader := bufio.NewReader(strings.NewReader("\n1\n"))
 
-	playerType, err := ReadPlayerType(reader, &output)
+	playerType, err := ReadPlayerType(reader, &output, testPlayerTypes)
 
 	if err != nil {
 		t.Fatalf("should eventually succeed: %v", err)
 	}
 
-	if playerType != Human {
+	if playerType != testHuman {
 		t.Errorf("should accept '1' after retry, got %v", playerType)
 	}
 
@@ -101,13 +109,13 @@ func TestReadPlayerType_HandlesMultipleInvalidInputs(t *testing.T) {
 	var output bytes.Buffer
 	reader := bufio.NewReader(strings.NewReader("5\nabc\n\n2\n"))
 
-	playerType, err := ReadPlayerType(reader, &output)
+	playerType, err := ReadPlayerType(reader, &output, testPlayerTypes)
 
 	if err != nil {
 		t.Fatalf("should eventually succeed: %v", err)
 	}
 
-	if playerType != AI {
+	if playerType != testAI {
 		t.Errorf("should accept '2' after multiple retries, got %v", playerType)
 	}
 
@@ -117,6 +125,21 @@ func TestReadPlayerType_HandlesMultipleInvalidInputs(t *testing.T) {
 	}
 }
 
+func TestReadPlayerType_AcceptsTitle(t *testing.T) {
+	var output bytes.Buffer
+	reader := bufio.NewReader(strings.NewReader("ai\n"))
+
+	playerType, err := ReadPlayerType(reader, &output, testPlayerTypes)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if playerType != testAI {
+		t.Errorf("input 'ai' should select AI, got %v", playerType)
+	}
+}
+
 func TestReadPlayAgain_AcceptsLowercaseY(t *testing.T) {
 	var output bytes.Buffer
 	reader := bufio.NewReader(strings.NewReader("y\n"))
@@ -322,8 +345,8 @@ func TestInputOutput_PlayerSelectionFlow(t *testing.T) {
 	var output bytes.Buffer
 	reader := bufio.NewReader(strings.NewReader(input))
 
-	ShowPlayerTypeSelection(&output, "X")
-	playerType, _ := ReadPlayerType(reader, &output)
+	ShowPlayerTypeSelection(&output, boards.PlayerX, testPlayerTypes)
+	playerType, _ := ReadPlayerType(reader, &output, testPlayerTypes)
 
 	result := output.String()
 
@@ -331,7 +354,7 @@ func TestInputOutput_PlayerSelectionFlow(t *testing.T) {
 		t.Error("should display player type options")
 	}
 
-	if playerType != Human {
+	if playerType != testHuman {
 		t.Error("should accept human player selection")
 	}
 }
@@ -354,3 +377,46 @@ func TestInputOutput_PlayAgainFlow(t *testing.T) {
 		t.Error("should accept yes response")
 	}
 }
+
+func TestReadFirstPlayer_AcceptsEachChoice(t *testing.T) {
+	choices := map[string]FirstPlayerChoice{
+		"1\n": XFirst,
+		"2\n": OFirst,
+		"3\n": RandomFirst,
+		"4\n": AlternateFirst,
+	}
+
+	for input, want := range choices {
+		var output bytes.Buffer
+		reader := bufio.NewReader(strings.NewReader(input))
+
+		choice, err := ReadFirstPlayer(reader, &output)
+
+		if err != nil {
+			t.Fatalf("unexpected error for %q: %v", input, err)
+		}
+
+		if choice != want {
+			t.Errorf("input %q: got %v, want %v", input, choice, want)
+		}
+	}
+}
+
+func TestReadFirstPlayer_RetriesAfterInvalidChoice(t *testing.T) {
+	var output bytes.Buffer
+	reader := bufio.NewReader(strings.NewReader("5\n\n2\n"))
+
+	choice, err := ReadFirstPlayer(reader, &output)
+
+	if err != nil {
+		t.Fatalf("should eventually succeed: %v", err)
+	}
+
+	if choice != OFirst {
+		t.Errorf("should accept 2 after retries, got %v", choice)
+	}
+
+	if strings.Count(output.String(), "Invalid input") != 2 {
+		t.Error("should report each invalid choice")
+	}
+}
@@ -3,33 +3,43 @@ package io
 import (
 	"fmt"
 	"io"
+	"strconv"
 	"strings"
+	"time"
 	"ttt/boards"
 )
 
 const (
-	PositionRange   = "1-9"
-	PlayerTypeRange = "1-2"
-	GridSeparator   = "-----------"
-	GridDivider     = " | "
-	CellPadding     = " "
-	NewlineChar     = "\n" // Does Go have an Environment variable for newline characters?
-	RowsPerBoard    = 3
-	ColumnsPerRow   = 3
+	PositionRange  = "1-9"
+	FirstMoveRange = "1-4"
+	GridSeparator  = "-----------"
+	GridDivider    = " | "
+	CellPadding    = " "
+	NewlineChar    = "\n" // Does Go have an Environment variable for newline characters?
+	RowsPerBoard   = 3
+	ColumnsPerRow  = 3
 )
 
 func ShowWelcome(writer io.Writer) {
 	fmt.Fprintln(writer, "Welcome to Tic-Tac-Toe!")
 }
 
-func ShowBoard(writer io.Writer, board boards.Board) {
+func ShowBoard(writer io.Writer, board boards.Board, roster Roster) {
+	ShowBoardView(writer, PlainRenderer{}, BoardView{Board: board, Roster: roster})
+}
+
+func ShowBoardView(writer io.Writer, renderer Renderer, view BoardView) {
 	fmt.Fprintln(writer, "")
-	fmt.Fprintln(writer, formatBoard(board))
+	fmt.Fprintln(writer, renderer.RenderBoard(view))
 	fmt.Fprintln(writer, "")
 }
 
-func ShowPlayerTurn(writer io.Writer, player string) {
-	fmt.Fprintf(writer, "Player %s's turn\n", player)
+func ShowPlayerTurn(writer io.Writer, player Identity) {
+	fmt.Fprintf(writer, "%s's turn\n", player.Name)
+}
+
+func ShowPlayerTurnWithClock(writer io.Writer, player Identity, timeLeft time.Duration) {
+	fmt.Fprintf(writer, "%s's turn (%s left)\n", player.Name, FormatClock(timeLeft))
 }
 
 func ShowPrompt(writer io.Writer) {
@@ -44,19 +54,52 @@ func ShowPositionTaken(writer io.Writer) {
 	fmt.Fprintln(writer, "Position already taken, try again")
 }
 
-func ShowWinner(writer io.Writer, player string) {
-	fmt.Fprintf(writer, "Player %s wins!\n", player)
+func ShowWinner(writer io.Writer, player Identity, lines ...boards.Line) {
+	fmt.Fprintf(writer, "%s wins!\n", player.Name)
+	for _, line := range lines {
+		fmt.Fprintf(writer, "Winning line: %s\n", formatLine(line))
+	}
+}
+
+func ShowForfeit(writer io.Writer, player Identity) {
+	fmt.Fprintf(writer, "%s forfeits.\n", player.Name)
+}
+
+func ShowIllegalMove(writer io.Writer, player Identity, position int, err error) {
+	fmt.Fprintf(writer, "%s made an illegal move (%d): %v\n", player.Name, position, err)
+}
+
+func ShowIllegalMoveForfeit(writer io.Writer, player Identity) {
+	fmt.Fprintf(writer, "%s forfeits after an illegal move.\n", player.Name)
+}
+
+func ShowOutOfTime(writer io.Writer, player Identity) {
+	fmt.Fprintf(writer, "%s ran out of time.\n", player.Name)
+}
+
+func ShowGameError(writer io.Writer, err error) {
+	fmt.Fprintf(writer, "Game ended early: %v\n", err)
 }
 
 func ShowDraw(writer io.Writer) {
 	fmt.Fprintln(writer, "Game Over! Board is full.")
 }
 
-func ShowPlayerTypeSelection(writer io.Writer, player string) {
+func ShowPlayerTypeSelection(writer io.Writer, player boards.Cell, items []MenuItem) {
 	fmt.Fprintf(writer, "Select Player %s type:\n", player)
-	fmt.Fprintln(writer, "1. Human")
-	fmt.Fprintln(writer, "2. AI")
-	fmt.Fprintf(writer, "Enter choice (%s): ", PlayerTypeRange)
+	for index, item := range items {
+		fmt.Fprintf(writer, "%d. %s\n", index+1, FormatMenuItem(item))
+	}
+	fmt.Fprintf(writer, "Enter choice (1-%d): ", len(items))
+}
+
+func ShowFirstPlayerSelection(writer io.Writer) {
+	fmt.Fprintln(writer, "Who moves first?")
+	fmt.Fprintln(writer, "1. Player X")
+	fmt.Fprintln(writer, "2. Player O")
+	fmt.Fprintln(writer, "3. Random")
+	fmt.Fprintln(writer, "4. Alternate each game")
+	fmt.Fprintf(writer, "Enter choice (%s): ", FirstMoveRange)
 }
 
 func ShowPlayAgainPrompt(writer io.Writer) {
@@ -72,21 +115,58 @@ func ShowNewline(writer io.Writer) {
 	fmt.Fprintln(writer, "")
 }
 
-func formatBoard(board boards.Board) string {
+// FormatClock shows minutes, seconds and tenths, like a chess clock.
+func FormatClock(left time.Duration) string {
+	tenths := max(left, 0) / (100 * time.Millisecond)
+	return fmt.Sprintf("%d:%02d.%d", tenths/600, tenths/10%60, tenths%10)
+}
+
+func FormatMenuItem(item MenuItem) string {
+	if item.Description == "" {
+		return item.Title
+	}
+	return item.Title + " - " + item.Description
+}
+
+func formatLine(line boards.Line) string {
+	positions := make([]string, len(line))
+	for index, position := range line {
+		positions[index] = strconv.Itoa(position)
+	}
+	return strings.Join(positions, "-")
+}
+
+func cellLabel(board boards.Board, roster Roster, row int, col int) string {
+	if board[row][col] == boards.Empty {
+		return padToken(strconv.Itoa(boards.PositionOf(row, col)), roster.cellWidth())
+	}
+	return padToken(roster.tokenFor(board[row][col]), roster.cellWidth())
+}
+
+func formatBoard(board boards.Board, roster Roster) string {
+	return formatGrid(roster.cellWidth(), func(row int, col int) string {
+		return cellLabel(board, roster, row, col)
+	})
+}
+
+// formatGrid lays out labels that each take width columns.
+func formatGrid(width int, label func(row int, col int) string) string {
+	separator := GridSeparator + strings.Repeat("---", width-1)
+
 	var display strings.Builder
 
 	for row := range RowsPerBoard {
 		display.WriteString(
 			CellPadding +
-				board[row][0] +
+				label(row, 0) +
 				GridDivider +
-				board[row][1] +
+				label(row, 1) +
 				GridDivider +
-				board[row][2] +
+				label(row, 2) +
 				CellPadding)
 
 		if row < RowsPerBoard-1 {
-			display.WriteString(NewlineChar + GridSeparator + NewlineChar)
+			display.WriteString(NewlineChar + separator + NewlineChar)
 		}
 	}
 
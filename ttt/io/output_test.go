@@ -2,8 +2,10 @@ package io
 
 import (
 	"bytes"
+	"errors"
 	"strings"
 	"testing"
+	"time"
 	"ttt/boards"
 )
 
@@ -33,7 +35,7 @@ func TestShowBoard_EmptyBoard(t *testing.T) {
 	var output bytes.Buffer
 	board := boards.NewBoard()
 
-	ShowBoard(&output, board)
+	ShowBoard(&output, board, DefaultRoster())
 
 	result := output.String()
 	for position := 1; position <= 9; position++ {
@@ -47,12 +49,12 @@ func TestShowBoard_EmptyBoard(t *testing.T) {
 func TestShowBoard_BoardWithMoves(t *testing.T) {
 	var output bytes.Buffer
 	board := boards.Board{
-		{"X", "2", "O"},
-		{"4", "X", "6"},
-		{"O", "8", "9"},
+		{boards.PlayerX, boards.Empty, boards.PlayerO},
+		{boards.Empty, boards.PlayerX, boards.Empty},
+		{boards.PlayerO, boards.Empty, boards.Empty},
 	}
 
-	ShowBoard(&output, board)
+	ShowBoard(&output, board, DefaultRoster())
 
 	result := output.String()
 	expectedContent := []string{"X", "O", "2", "4", "6", "8", "9"}
@@ -66,12 +68,12 @@ func TestShowBoard_BoardWithMoves(t *testing.T) {
 func TestShowBoard_FullBoard(t *testing.T) {
 	var output bytes.Buffer
 	board := boards.Board{
-		{"X", "O", "X"},
-		{"O", "X", "O"},
-		{"O", "X", "X"},
+		{boards.PlayerX, boards.PlayerO, boards.PlayerX},
+		{boards.PlayerO, boards.PlayerX, boards.PlayerO},
+		{boards.PlayerO, boards.PlayerX, boards.PlayerX},
 	}
 
-	ShowBoard(&output, board)
+	ShowBoard(&output, board, DefaultRoster())
 
 	result := output.String()
 	xCount := strings.Count(result, "X")
@@ -89,7 +91,7 @@ func TestShowBoard_GridStructure(t *testing.T) {
 	board := boards.NewBoard()
 	var output bytes.Buffer
 
-	ShowBoard(&output, board)
+	ShowBoard(&output, board, DefaultRoster())
 
 	result := output.String()
 	if !strings.Contains(result, "|") {
@@ -104,7 +106,7 @@ func TestShowBoard_GridStructure(t *testing.T) {
 func TestShowPlayerTurn_XTurn(t *testing.T) {
 	var output bytes.Buffer
 
-	ShowPlayerTurn(&output, "X")
+	ShowPlayerTurn(&output, DefaultIdentity(boards.PlayerX))
 
 	result := output.String()
 	if !strings.Contains(result, "X") {
@@ -118,7 +120,7 @@ func TestShowPlayerTurn_XTurn(t *testing.T) {
 func TestShowPlayerTurn_OTurn(t *testing.T) {
 	var output bytes.Buffer
 
-	ShowPlayerTurn(&output, "O")
+	ShowPlayerTurn(&output, DefaultIdentity(boards.PlayerO))
 
 	result := output.String()
 	if !strings.Contains(result, "O") {
@@ -165,7 +167,7 @@ func TestShowPositionTaken_DisplaysMessage(t *testing.T) {
 func TestShowWinner_XVictory(t *testing.T) {
 	var output bytes.Buffer
 
-	ShowWinner(&output, "X")
+	ShowWinner(&output, DefaultIdentity(boards.PlayerX))
 
 	result := output.String()
 	if !strings.Contains(result, "X") {
@@ -179,7 +181,7 @@ func TestShowWinner_XVictory(t *testing.T) {
 func TestShowWinner_OVictory(t *testing.T) {
 	var output bytes.Buffer
 
-	ShowWinner(&output, "O")
+	ShowWinner(&output, DefaultIdentity(boards.PlayerO))
 
 	result := output.String()
 	if !strings.Contains(result, "O") {
@@ -215,7 +217,7 @@ func TestShowDraw_BoardFull(t *testing.T) {
 func TestShowPlayerTypeSelection_DisplaysOptionsForX(t *testing.T) {
 	var output bytes.Buffer
 
-	ShowPlayerTypeSelection(&output, "X")
+	ShowPlayerTypeSelection(&output, boards.PlayerX, testPlayerTypes)
 
 	result := output.String()
 	requiredContent := []string{"X", "Human", "AI", "1", "2"}
@@ -229,7 +231,7 @@ func TestShowPlayerTypeSelection_DisplaysOptionsForX(t *testing.T) {
 func TestShowPlayerTypeSelection_DisplaysOptionsForO(t *testing.T) {
 	var output bytes.Buffer
 
-	ShowPlayerTypeSelection(&output, "O")
+	ShowPlayerTypeSelection(&output, boards.PlayerO, testPlayerTypes)
 
 	result := output.String()
 	requiredContent := []string{"O", "Human", "AI", "1", "2"}
@@ -240,6 +242,18 @@ func TestShowPlayerTypeSelection_DisplaysOptionsForO(t *testing.T) {
 	}
 }
 
+func TestShowPlayerTypeSelection_NumbersEveryItem(t *testing.T) {
+	var output bytes.Buffer
+	items := []MenuItem{{Title: "Human"}, {Title: "AI"}, {Title: "Rules", Description: "no search"}}
+
+	ShowPlayerTypeSelection(&output, boards.PlayerX, items)
+
+	result := output.String()
+	if !strings.Contains(result, "3. Rules - no search") || !strings.Contains(result, "(1-3)") {
+		t.Errorf("should number each item and show the range, got %q", result)
+	}
+}
+
 func TestShowPlayAgainPrompt_AsksPlayAgain(t *testing.T) {
 	var output bytes.Buffer
 
@@ -272,3 +286,60 @@ func TestShowGoodbye_DisplaysGoodBye(t *testing.T) {
 		t.Error("should thank user for playing")
 	}
 }
+
+func TestShowForfeit_NamesPlayer(t *testing.T) {
+	var output bytes.Buffer
+
+	ShowForfeit(&output, DefaultIdentity(boards.PlayerO))
+
+	result := output.String()
+	if !strings.Contains(result, "O") || !strings.Contains(result, "forfeits") {
+		t.Error("should announce which player forfeits")
+	}
+}
+
+func TestShowGameError_IncludesError(t *testing.T) {
+	var output bytes.Buffer
+
+	ShowGameError(&output, errors.New("unexpected EOF"))
+
+	result := output.String()
+	if !strings.Contains(result, "unexpected EOF") {
+		t.Error("should include the error message")
+	}
+}
+
+func TestShowFirstPlayerSelection_DisplaysOptions(t *testing.T) {
+	var output bytes.Buffer
+
+	ShowFirstPlayerSelection(&output)
+
+	result := output.String()
+	requiredContent := []string{"first", "Player X", "Player O", "Random", "Alternate"}
+	for _, content := range requiredContent {
+		if !strings.Contains(result, content) {
+			t.Errorf("should include %q", content)
+		}
+	}
+}
+
+func TestShowWinner_ListsWinningLines(t *testing.T) {
+	var output bytes.Buffer
+
+	ShowWinner(&output, DefaultIdentity(boards.PlayerX), boards.Line{1, 2, 3}, boards.Line{3, 5, 7})
+
+	result := output.String()
+	if !strings.Contains(result, "1-2-3") || !strings.Contains(result, "3-5-7") {
+		t.Error("should list each winning line")
+	}
+}
+
+func TestShowPlayerTurn_WithClock(t *testing.T) {
+	var output bytes.Buffer
+
+	ShowPlayerTurnWithClock(&output, DefaultIdentity(boards.PlayerO), 83*time.Second+450*time.Millisecond)
+
+	if got := output.String(); got != "Player O's turn (1:23.4 left)\n" {
+		t.Errorf("unexpected turn line %q", got)
+	}
+}
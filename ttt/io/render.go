@@ -0,0 +1,149 @@
+package io
+
+import (
+	"errors"
+	"io"
+	"os"
+	"slices"
+	"ttt/boards"
+)
+
+const (
+	ansiReset     = "\x1b[0m"
+	ansiBold      = "\x1b[1m"
+	ansiDim       = "\x1b[2m"
+	ansiUnderline = "\x1b[4m"
+	ansiReverse   = "\x1b[7m"
+	ansiRed       = "\x1b[31m"
+	ansiBlue      = "\x1b[34m"
+	ansiGreenBack = "\x1b[42m"
+)
+
+type BoardView struct {
+	Board    boards.Board
+	Roster   Roster
+	LastMove int
+	Cursor   int
+	Lines    []boards.Line
+}
+
+type Renderer interface {
+	RenderBoard(view BoardView) string
+}
+
+type PlainRenderer struct{}
+
+func (PlainRenderer) RenderBoard(view BoardView) string {
+	return formatBoard(view.Board, view.Roster)
+}
+
+type ANSIRenderer struct{}
+
+func (ANSIRenderer) RenderBoard(view BoardView) string {
+	return formatGrid(view.Roster.cellWidth(), func(row int, col int) string {
+		position := boards.PositionOf(row, col)
+		label := cellLabel(view.Board, view.Roster, row, col)
+
+		style := ansiDim
+		switch view.Board[row][col] {
+		case boards.PlayerX:
+			style = ansiBold + ansiRed
+		case boards.PlayerO:
+			style = ansiBold + ansiBlue
+		}
+
+		if position == view.LastMove {
+			style += ansiUnderline
+		}
+
+		if onLine(view.Lines, position) {
+			style += ansiGreenBack
+		}
+
+		if position == view.Cursor {
+			style += ansiReverse
+		}
+
+		return style + label + ansiReset
+	})
+}
+
+// MonochromeRenderer is the plain board for screens without color; only the
+// cursor is marked, in reverse video, so it can still be moved.
+type MonochromeRenderer struct{}
+
+func (MonochromeRenderer) RenderBoard(view BoardView) string {
+	return formatGrid(view.Roster.cellWidth(), func(row int, col int) string {
+		label := cellLabel(view.Board, view.Roster, row, col)
+		if boards.PositionOf(row, col) == view.Cursor {
+			return ansiReverse + label + ansiReset
+		}
+		return label
+	})
+}
+
+func onLine(lines []boards.Line, position int) bool {
+	for _, line := range lines {
+		if slices.Contains(line[:], position) {
+			return true
+		}
+	}
+	return false
+}
+
+type ColorMode int
+
+const (
+	ColorAuto ColorMode = iota
+	ColorAlways
+	ColorNever
+)
+
+var ErrInvalidColorMode = errors.New("Color mode must be auto, always or never")
+
+func ParseColorMode(input string) (ColorMode, error) {
+	switch input {
+	case "auto":
+		return ColorAuto, nil
+	case "always":
+		return ColorAlways, nil
+	case "never":
+		return ColorNever, nil
+	default:
+		return ColorAuto, ErrInvalidColorMode
+	}
+}
+
+func ChooseRenderer(mode ColorMode, writer io.Writer) Renderer {
+	if UseColor(mode, writer) {
+		return ANSIRenderer{}
+	}
+	return PlainRenderer{}
+}
+
+// UseColor reports whether output to writer should be colored in mode.
+func UseColor(mode ColorMode, writer io.Writer) bool {
+	if mode == ColorAuto {
+		return supportsColor(writer, os.Getenv)
+	}
+	return mode == ColorAlways
+}
+
+// supportsColor follows https://no-color.org: any non-empty NO_COLOR turns
+// color off, as does a dumb terminal or output that is not a terminal.
+func supportsColor(writer io.Writer, getenv func(string) string) bool {
+	if getenv("NO_COLOR") != EmptyInput || getenv("TERM") == "dumb" {
+		return false
+	}
+
+	file, ok := writer.(*os.File)
+	if !ok {
+		return false
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		return false
+	}
+	return info.Mode()&os.ModeCharDevice != 0
+}
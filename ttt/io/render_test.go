@@ -0,0 +1,167 @@
+package io
+
+import (
+	"bytes"
+	"os"
+	"strings"
+	"testing"
+	"ttt/boards"
+)
+
+var renderBoard = boards.Board{
+	{boards.PlayerX, boards.PlayerX, boards.PlayerX},
+	{boards.PlayerO, boards.PlayerO, boards.Empty},
+	{boards.Empty, boards.Empty, boards.Empty},
+}
+
+func noEnv(string) string {
+	return ""
+}
+
+func TestPlainRenderer_MatchesShowBoard(t *testing.T) {
+	var output bytes.Buffer
+	ShowBoard(&output, renderBoard, DefaultRoster())
+
+	rendered := PlainRenderer{}.RenderBoard(BoardView{Board: renderBoard, Roster: DefaultRoster()})
+
+	if !strings.Contains(output.String(), rendered) {
+		t.Error("plain renderer should produce the same grid as ShowBoard")
+	}
+
+	if strings.Contains(rendered, "\x1b[") {
+		t.Error("plain renderer should not emit escape codes")
+	}
+}
+
+func TestANSIRenderer_ColorsPlayersDifferently(t *testing.T) {
+	rendered := ANSIRenderer{}.RenderBoard(BoardView{Board: renderBoard, Roster: DefaultRoster()})
+
+	if !strings.Contains(rendered, ansiRed+"X") {
+		t.Error("X should be red")
+	}
+
+	if !strings.Contains(rendered, ansiBlue+"O") {
+		t.Error("O should be blue")
+	}
+
+	if !strings.Contains(rendered, ansiDim+"6"+ansiReset) {
+		t.Error("empty cell numbers should be dimmed")
+	}
+}
+
+func TestANSIRenderer_HighlightsLastMoveAndWinningLine(t *testing.T) {
+	rendered := ANSIRenderer{}.RenderBoard(BoardView{
+		Board:    renderBoard,
+		Roster:   DefaultRoster(),
+		LastMove: 3,
+		Lines:    []boards.Line{{1, 2, 3}},
+	})
+
+	if strings.Count(rendered, ansiGreenBack) != 3 {
+		t.Errorf("should highlight the three winning cells, got %d", strings.Count(rendered, ansiGreenBack))
+	}
+
+	if strings.Count(rendered, ansiUnderline) != 1 {
+		t.Error("should underline only the last move")
+	}
+}
+
+func TestANSIRenderer_KeepsGridLayout(t *testing.T) {
+	rendered := ANSIRenderer{}.RenderBoard(BoardView{Board: renderBoard, Roster: DefaultRoster()})
+
+	if strings.Count(rendered, GridSeparator) != 2 || strings.Count(rendered, GridDivider) != 6 {
+		t.Error("colored board should keep the plain grid layout")
+	}
+}
+
+func TestParseColorMode(t *testing.T) {
+	modes := map[string]ColorMode{"auto": ColorAuto, "always": ColorAlways, "never": ColorNever}
+	for input, want := range modes {
+		if mode, err := ParseColorMode(input); err != nil || mode != want {
+			t.Errorf("%q: got %v %v", input, mode, err)
+		}
+	}
+
+	if _, err := ParseColorMode("rainbow"); err != ErrInvalidColorMode {
+		t.Errorf("should reject unknown mode, got %v", err)
+	}
+}
+
+func TestChooseRenderer_ExplicitModes(t *testing.T) {
+	var output bytes.Buffer
+
+	if _, ok := ChooseRenderer(ColorAlways, &output).(ANSIRenderer); !ok {
+		t.Error("always should pick the ANSI renderer")
+	}
+
+	if _, ok := ChooseRenderer(ColorNever, os.Stdout).(PlainRenderer); !ok {
+		t.Error("never should pick the plain renderer")
+	}
+}
+
+func TestChooseRenderer_AutoDisablesForNonTerminal(t *testing.T) {
+	var output bytes.Buffer
+
+	if _, ok := ChooseRenderer(ColorAuto, &output).(PlainRenderer); !ok {
+		t.Error("auto should not color a buffer")
+	}
+}
+
+func TestSupportsColor_RespectsNoColor(t *testing.T) {
+	getenv := func(name string) string {
+		if name == "NO_COLOR" {
+			return "1"
+		}
+		return ""
+	}
+
+	if supportsColor(os.Stdout, getenv) {
+		t.Error("NO_COLOR should disable color")
+	}
+}
+
+func TestSupportsColor_RejectsRegularFile(t *testing.T) {
+	file, err := os.CreateTemp(t.TempDir(), "board")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer file.Close()
+
+	if supportsColor(file, noEnv) {
+		t.Error("regular files are not terminals")
+	}
+}
+
+func TestANSIRenderer_HighlightsCursor(t *testing.T) {
+	rendered := ANSIRenderer{}.RenderBoard(BoardView{
+		Board:  renderBoard,
+		Roster: DefaultRoster(),
+		Cursor: 6,
+	})
+
+	if strings.Count(rendered, ansiReverse) != 1 {
+		t.Errorf("expected exactly one cursor cell, got:\n%q", rendered)
+	}
+
+	if !strings.Contains(rendered, ansiDim+ansiReverse+"6"+ansiReset) {
+		t.Errorf("cursor should be drawn on position 6, got:\n%q", rendered)
+	}
+}
+
+func TestMonochromeRenderer_MarksOnlyTheCursor(t *testing.T) {
+	rendered := MonochromeRenderer{}.RenderBoard(BoardView{
+		Board:    renderBoard,
+		Roster:   DefaultRoster(),
+		Cursor:   6,
+		LastMove: 1,
+	})
+
+	if !strings.Contains(rendered, ansiReverse+"6"+ansiReset) {
+		t.Errorf("cursor cell should be in reverse video, got %q", rendered)
+	}
+
+	withoutCursor := strings.Replace(rendered, ansiReverse+"6"+ansiReset, "6", 1)
+	if plain := (PlainRenderer{}).RenderBoard(BoardView{Board: renderBoard, Roster: DefaultRoster()}); withoutCursor != plain {
+		t.Errorf("everything but the cursor should be plain, got %q", rendered)
+	}
+}
@@ -0,0 +1,88 @@
+package io
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Tokens are single user-perceived characters, which for emoji may be
+// several code points: a base with modifiers, a ZWJ sequence or a flag.
+
+const (
+	emptyToken      = ""
+	zeroWidthJoiner = '\u200d'
+	emojiStyle      = '\ufe0f'
+)
+
+func isExtender(symbol rune) bool {
+	return unicode.In(symbol, unicode.Mn, unicode.Me) ||
+		(symbol >= 0xFE00 && symbol <= 0xFE0F) || // variation selectors
+		(symbol >= 0x1F3FB && symbol <= 0x1F3FF) || // skin tones
+		(symbol >= 0xE0020 && symbol <= 0xE007F) // tags, for subdivision flags
+}
+
+func isRegionalIndicator(symbol rune) bool {
+	return symbol >= 0x1F1E6 && symbol <= 0x1F1FF
+}
+
+// singleGrapheme reports whether token is one user-perceived character. It
+// covers combining marks, emoji modifiers, ZWJ sequences and flags rather
+// than the full Unicode segmentation rules.
+func singleGrapheme(token string) bool {
+	symbols := []rune(token)
+	if len(symbols) == 0 || isExtender(symbols[0]) || symbols[0] == zeroWidthJoiner {
+		return false
+	}
+
+	if len(symbols) == 2 && isRegionalIndicator(symbols[0]) && isRegionalIndicator(symbols[1]) {
+		return true
+	}
+
+	for index := 1; index < len(symbols); index++ {
+		switch {
+		case isExtender(symbols[index]):
+		case symbols[index] == zeroWidthJoiner && index+1 < len(symbols) &&
+			!isExtender(symbols[index+1]) && symbols[index+1] != zeroWidthJoiner:
+			index++
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+func isWide(symbol rune) bool {
+	switch {
+	case symbol >= 0x1100 && symbol <= 0x115F,
+		symbol >= 0x2E80 && symbol <= 0xA4CF,
+		symbol >= 0xAC00 && symbol <= 0xD7A3,
+		symbol >= 0xF900 && symbol <= 0xFAFF,
+		symbol >= 0xFE30 && symbol <= 0xFE4F,
+		symbol >= 0xFF00 && symbol <= 0xFF60,
+		symbol >= 0xFFE0 && symbol <= 0xFFE6,
+		symbol >= 0x1F000 && symbol <= 0x1FAFF,
+		symbol >= 0x20000 && symbol <= 0x3FFFD:
+		return true
+	}
+	return false
+}
+
+// displayWidth is how many terminal columns token takes: two for emoji and
+// East Asian wide characters, one otherwise.
+func displayWidth(token string) int {
+	if token == emptyToken {
+		return 0
+	}
+
+	first, _ := utf8.DecodeRuneInString(token)
+	if isWide(first) || strings.ContainsRune(token, emojiStyle) {
+		return 2
+	}
+	return 1
+}
+
+// padToken fills token with spaces to width columns.
+func padToken(token string, width int) string {
+	return token + strings.Repeat(" ", max(width-displayWidth(token), 0))
+}
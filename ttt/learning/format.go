@@ -0,0 +1,93 @@
+package learning
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"ttt/boards"
+)
+
+// File layout (all integers big-endian):
+//
+//	magic    [4]byte  "TTTL"
+//	version  uint8    1
+//	count    uint32   number of boxes
+//	boxes    count × { index uint16, beads [9]uint16 }
+//
+// Boxes are sorted by boards.Index of the normalised position; beads[p-1]
+// is the bead count for position p.
+const (
+	Magic       = "TTTL"
+	Version     = 1
+	headerBytes = len(Magic) + 1 + 4
+	boxBytes    = boards.IndexBytes + 2*boards.TotalCells
+)
+
+var (
+	ErrBadMagic   = errors.New("learning: bad magic")
+	ErrBadVersion = errors.New("learning: unsupported version")
+	ErrBadIndex   = errors.New("learning: box index out of range")
+)
+
+func (table *Table) WriteTo(writer io.Writer) (int64, error) {
+	buffered := bufio.NewWriter(writer)
+
+	data := []byte(Magic)
+	data = append(data, Version)
+	data = binary.BigEndian.AppendUint32(data, uint32(len(table.boxes)))
+	for _, index := range slices.Sorted(maps.Keys(table.boxes)) {
+		data = binary.BigEndian.AppendUint16(data, uint16(index))
+		for _, beads := range table.boxes[index] {
+			data = binary.BigEndian.AppendUint16(data, beads)
+		}
+	}
+
+	written, err := buffered.Write(data)
+	if err != nil {
+		return int64(written), err
+	}
+	return int64(written), buffered.Flush()
+}
+
+func Read(reader io.Reader) (*Table, error) {
+	buffered := bufio.NewReader(reader)
+
+	header := make([]byte, headerBytes)
+	if _, err := io.ReadFull(buffered, header); err != nil {
+		return nil, fmt.Errorf("learning: reading header: %w", err)
+	}
+
+	if string(header[:len(Magic)]) != Magic {
+		return nil, ErrBadMagic
+	}
+
+	if header[len(Magic)] != Version {
+		return nil, ErrBadVersion
+	}
+
+	table := NewTable()
+	count := binary.BigEndian.Uint32(header[len(Magic)+1:])
+	record := make([]byte, boxBytes)
+	for range count {
+		if _, err := io.ReadFull(buffered, record); err != nil {
+			return nil, fmt.Errorf("learning: reading boxes: %w", err)
+		}
+
+		index := boards.Index(binary.BigEndian.Uint16(record))
+		if index >= boards.IndexCount {
+			return nil, ErrBadIndex
+		}
+
+		box := &Box{}
+		for cell := range box {
+			box[cell] = binary.BigEndian.Uint16(record[boards.IndexBytes+2*cell:])
+		}
+		table.boxes[index] = box
+	}
+
+	return table, nil
+}
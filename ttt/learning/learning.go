@@ -0,0 +1,125 @@
+package learning
+
+import (
+	"math"
+	"math/rand"
+	"ttt/boards"
+)
+
+// A Table is a set of MENACE matchboxes: one box of beads per position, with
+// a bead count for each empty cell. Positions are stored as seen by the side
+// to move playing X, reduced by symmetry, so one table serves both sides.
+const (
+	InitialBeads = 4
+	WinBeads     = 3
+	DrawBeads    = 1
+	LossBeads    = 1
+	MaxBeads     = math.MaxUint16
+)
+
+type Box [boards.TotalCells]uint16
+
+type Table struct {
+	boxes map[boards.Index]*Box
+}
+
+func NewTable() *Table {
+	return &Table{boxes: map[boards.Index]*Box{}}
+}
+
+func (table *Table) Len() int {
+	return len(table.boxes)
+}
+
+func newBox(board boards.Board) *Box {
+	box := &Box{}
+	for _, position := range board.AvailableMoves() {
+		box[position-boards.MinPosition] = InitialBeads
+	}
+	return box
+}
+
+// Lookup returns the box for a normalised board, filling a new one with
+// InitialBeads per empty cell on first sight.
+func (table *Table) Lookup(board boards.Board) *Box {
+	index := board.Index()
+	box, ok := table.boxes[index]
+	if !ok {
+		box = newBox(board)
+		table.boxes[index] = box
+	}
+	return box
+}
+
+// Draw picks a position with probability proportional to its beads. A box
+// that has run out of beads is refilled, which keeps the player moving
+// instead of resigning.
+func (box *Box) Draw(board boards.Board, random *rand.Rand) int {
+	total := 0
+	for _, beads := range box {
+		total += int(beads)
+	}
+
+	if total == 0 {
+		*box = *newBox(board)
+		return box.Draw(board, random)
+	}
+
+	target := random.Intn(total)
+	for cell, beads := range box {
+		target -= int(beads)
+		if target < 0 {
+			return cell + boards.MinPosition
+		}
+	}
+	return boards.MaxPosition
+}
+
+func (box *Box) Reward(position int, outcome Outcome) {
+	cell := position - boards.MinPosition
+	switch outcome {
+	case Won:
+		box[cell] = uint16(min(int(box[cell])+WinBeads, MaxBeads))
+	case Drew:
+		box[cell] = uint16(min(int(box[cell])+DrawBeads, MaxBeads))
+	case Lost:
+		box[cell] -= min(box[cell], LossBeads)
+	}
+}
+
+type Outcome int
+
+const (
+	Won Outcome = iota
+	Drew
+	Lost
+)
+
+func (outcome Outcome) String() string {
+	switch outcome {
+	case Won:
+		return "won"
+	case Drew:
+		return "drew"
+	default:
+		return "lost"
+	}
+}
+
+// OutcomeFor reads a finished game's status from player's side.
+func OutcomeFor(status boards.GameStatus, player boards.Cell) Outcome {
+	switch status {
+	case boards.XWins:
+		if player == boards.PlayerX {
+			return Won
+		}
+		return Lost
+	case boards.OWins:
+		if player == boards.PlayerO {
+			return Won
+		}
+		return Lost
+	default:
+		return Drew
+	}
+}
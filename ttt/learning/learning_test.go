@@ -0,0 +1,141 @@
+package learning
+
+import (
+	"bytes"
+	"errors"
+	"math/rand"
+	"testing"
+	"ttt/boards"
+)
+
+func TestTable_LookupFillsNewBoxes(t *testing.T) {
+	table := NewTable()
+	board := boards.Board{
+		{boards.PlayerX, boards.Empty, boards.Empty},
+		{boards.Empty, boards.PlayerO, boards.Empty},
+		{boards.Empty, boards.Empty, boards.Empty},
+	}
+
+	box := table.Lookup(board)
+
+	for position := boards.MinPosition; position <= boards.MaxPosition; position++ {
+		expected := uint16(InitialBeads)
+		if !board.IsPositionValid(position) {
+			expected = 0
+		}
+		if box[position-boards.MinPosition] != expected {
+			t.Errorf("position %d: expected %d beads, got %d", position, expected, box[position-boards.MinPosition])
+		}
+	}
+
+	if table.Lookup(board) != box || table.Len() != 1 {
+		t.Error("a second lookup should return the same box")
+	}
+}
+
+func TestBox_DrawOnlyPicksEmptyCells(t *testing.T) {
+	board := boards.Board{
+		{boards.PlayerX, boards.PlayerO, boards.PlayerX},
+		{boards.Empty, boards.PlayerO, boards.Empty},
+		{boards.Empty, boards.PlayerX, boards.Empty},
+	}
+	box := NewTable().Lookup(board)
+	random := rand.New(rand.NewSource(1))
+
+	for range 100 {
+		if position := box.Draw(board, random); !board.IsPositionValid(position) {
+			t.Fatalf("drew occupied position %d", position)
+		}
+	}
+}
+
+func TestBox_EmptyBoxIsRefilled(t *testing.T) {
+	board := boards.NewBoard()
+	box := &Box{}
+
+	position := box.Draw(board, rand.New(rand.NewSource(1)))
+
+	if !board.IsPositionValid(position) || box[0] != InitialBeads {
+		t.Errorf("expected a refilled box and a legal move, got %d and %v", position, box)
+	}
+}
+
+func TestBox_Reward(t *testing.T) {
+	box := NewTable().Lookup(boards.NewBoard())
+
+	box.Reward(1, Won)
+	box.Reward(2, Drew)
+	box.Reward(3, Lost)
+	for range InitialBeads {
+		box.Reward(4, Lost)
+	}
+
+	expected := []uint16{InitialBeads + WinBeads, InitialBeads + DrawBeads, InitialBeads - LossBeads, 0}
+	for index, beads := range expected {
+		if box[index] != beads {
+			t.Errorf("position %d: expected %d beads, got %d", index+1, beads, box[index])
+		}
+	}
+
+	box[4] = MaxBeads
+	box.Reward(5, Won)
+	if box[4] != MaxBeads {
+		t.Errorf("beads should saturate at %d, got %d", MaxBeads, box[4])
+	}
+}
+
+func TestOutcomeFor(t *testing.T) {
+	tests := []struct {
+		status   boards.GameStatus
+		player   boards.Cell
+		expected Outcome
+	}{
+		{boards.XWins, boards.PlayerX, Won},
+		{boards.XWins, boards.PlayerO, Lost},
+		{boards.OWins, boards.PlayerO, Won},
+		{boards.OWins, boards.PlayerX, Lost},
+		{boards.Draw, boards.PlayerX, Drew},
+	}
+
+	for _, test := range tests {
+		if got := OutcomeFor(test.status, test.player); got != test.expected {
+			t.Errorf("OutcomeFor(%d, %s): expected %s, got %s", test.status, test.player, test.expected, got)
+		}
+	}
+}
+
+func TestTable_RoundTrip(t *testing.T) {
+	table := NewTable()
+	table.Lookup(boards.NewBoard()).Reward(5, Won)
+	board := boards.NewBoard()
+	board.MakeMove(1, boards.PlayerX)
+	table.Lookup(board).Reward(9, Lost)
+
+	var encoded bytes.Buffer
+	if _, err := table.WriteTo(&encoded); err != nil {
+		t.Fatalf("write failed: %v", err)
+	}
+
+	decoded, err := Read(&encoded)
+	if err != nil {
+		t.Fatalf("read failed: %v", err)
+	}
+
+	if decoded.Len() != 2 {
+		t.Fatalf("expected 2 boxes, got %d", decoded.Len())
+	}
+	if *decoded.Lookup(boards.NewBoard()) != *table.Lookup(boards.NewBoard()) ||
+		*decoded.Lookup(board) != *table.Lookup(board) {
+		t.Error("boxes should survive a round trip")
+	}
+}
+
+func TestRead_RejectsBadHeader(t *testing.T) {
+	if _, err := Read(bytes.NewReader([]byte("NOPE\x01\x00\x00\x00\x00"))); !errors.Is(err, ErrBadMagic) {
+		t.Errorf("expected ErrBadMagic, got %v", err)
+	}
+
+	if _, err := Read(bytes.NewReader([]byte("TTTL\x02\x00\x00\x00\x00"))); !errors.Is(err, ErrBadVersion) {
+		t.Errorf("expected ErrBadVersion, got %v", err)
+	}
+}
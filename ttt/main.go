@@ -1,9 +1,114 @@
 package main
 
 import (
+	"flag"
+	"fmt"
+	"os"
+	"time"
+	"ttt/boards"
 	"ttt/game"
+	tttio "ttt/io"
+	"ttt/tui"
 )
 
+func buildIdentity(name string, token string, symbol boards.Cell) tttio.Identity {
+	identity, err := tttio.NewIdentity(name, token)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Player %s: %v\n", symbol, err)
+		os.Exit(2)
+	}
+	return identity
+}
+
 func main() {
-	game.StartGame()
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "train":
+			runTrain(os.Args[2:])
+			return
+		case "engine":
+			runEngine(os.Args[2:])
+			return
+		case "match":
+			runMatch(os.Args[2:])
+			return
+		case "players":
+			runPlayers(os.Args[2:])
+			return
+		}
+	}
+
+	xName := flag.String("x-name", "Player X", "display name for player X")
+	xToken := flag.String("x-token", boards.PlayerX.String(), "board token for player X")
+	oName := flag.String("o-name", "Player O", "display name for player O")
+	oToken := flag.String("o-token", boards.PlayerO.String(), "board token for player O")
+	color := flag.String("color", "auto", "colored board: auto, always or never")
+	ui := flag.String("ui", "line", "interface: line or tui (full screen, needs a terminal)")
+	total := flag.Duration("time", 0, "thinking time per player per game, e.g. 5m (0 is unlimited)")
+	increment := flag.Duration("increment", 0, "time added to a player's clock after each move")
+	moveTime := flag.Duration("move-time", 0, "time limit for each move, e.g. 10s (0 is unlimited)")
+	playerX := flag.String("x", "", kindUsage("X"))
+	playerO := flag.String("o", "", kindUsage("O"))
+	kinds := registerKindFlags(flag.CommandLine)
+	flag.Parse()
+
+	checkKind(*playerX)
+	checkKind(*playerO)
+
+	colorMode, err := tttio.ParseColorMode(*color)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+
+	roster, err := tttio.NewRoster(
+		buildIdentity(*xName, *xToken, boards.PlayerX),
+		buildIdentity(*oName, *oToken, boards.PlayerO))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+
+	options := game.SessionOptions{
+		Roster:      roster,
+		TimeControl: game.TimeControl{Total: *total, Increment: *increment, PerMove: *moveTime},
+		PlayerX:     *playerX,
+		PlayerO:     *playerO,
+		KindOptions: kinds.options(),
+	}
+
+	switch *ui {
+	case "line":
+		game.StartGame(colorMode, options)
+	case "tui":
+		startTUI(colorMode, options)
+	default:
+		fmt.Fprintln(os.Stderr, "UI must be line or tui")
+		os.Exit(2)
+	}
+}
+
+func startTUI(colorMode tttio.ColorMode, options game.SessionOptions) {
+	if !tui.IsTerminal(os.Stdin) {
+		fmt.Fprintln(os.Stderr, "Input is not a terminal, using the line interface")
+		game.StartGame(colorMode, options)
+		return
+	}
+
+	score, err := tui.Run(os.Stdin, os.Stdout, tui.Options{
+		Roster:      options.Roster,
+		Random:      game.NewRandom(time.Now().UnixNano()),
+		TimeControl: options.TimeControl,
+		PlayerX:     options.PlayerX,
+		PlayerO:     options.PlayerO,
+		KindOptions: options.KindOptions,
+		Color:       colorMode,
+	})
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	fmt.Println(tui.FormatScore(options.Roster, score))
+	tttio.ShowGoodbye(os.Stdout)
 }
@@ -0,0 +1,72 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"ttt/players"
+)
+
+// kindFlags holds a -<kind>-<option> flag for every option a registered
+// player kind declares.
+type kindFlags map[string]map[string]*string
+
+func registerKindFlags(flags *flag.FlagSet) kindFlags {
+	values := kindFlags{}
+	for _, kind := range players.Kinds() {
+		values[kind.Name] = map[string]*string{}
+		for _, option := range kind.Options {
+			usage := fmt.Sprintf("%s player: %s", kind.Name, option.Usage)
+			values[kind.Name][option.Name] = flags.String(kind.Name+"-"+option.Name, option.Default, usage)
+		}
+	}
+	return values
+}
+
+func (values kindFlags) options() map[string]map[string]string {
+	options := map[string]map[string]string{}
+	for kind, flags := range values {
+		options[kind] = map[string]string{}
+		for name, value := range flags {
+			options[kind][name] = *value
+		}
+	}
+	return options
+}
+
+func kindUsage(symbol string) string {
+	return fmt.Sprintf("player %s: %s (empty asks each game)", symbol, strings.Join(players.KindNames(), ", "))
+}
+
+func checkKind(name string) {
+	if _, ok := players.LookupKind(name); name != "" && !ok {
+		fmt.Fprintf(os.Stderr, "%v %q; choose from %s\n", players.ErrUnknownKind, name, strings.Join(players.KindNames(), ", "))
+		os.Exit(2)
+	}
+}
+
+// runPlayers lists the registered player kinds, as JSON for other programs.
+func runPlayers(args []string) {
+	flags := flag.NewFlagSet("players", flag.ExitOnError)
+	asJSON := flags.Bool("json", false, "print the registry as JSON")
+	flags.Parse(args)
+
+	if *asJSON {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(players.Kinds()); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	for _, kind := range players.Kinds() {
+		fmt.Printf("%-10s %s\n", kind.Name, kind.Description)
+		for _, option := range kind.Options {
+			fmt.Printf("  -%s-%s  %s\n", kind.Name, option.Name, option.Usage)
+		}
+	}
+}
@@ -1,26 +1,118 @@
 package players
 
 import (
-	"bufio"
-	"io"
+	"context"
+	"errors"
 	"ttt/boards"
-	tttio "ttt/io"
 )
 
+var ErrForfeit = errors.New("player forfeited")
+
 type Player interface {
 	ReadMove(board boards.Board) (int, error)
 	//GetToken() string // maybe?
 }
 
-func CreatePlayer(
-	playerType tttio.PlayerType,
-	symbol string,
-	opponentSymbol string,
-	reader *bufio.Reader,
-	output io.Writer,
-) Player {
-	if playerType == tttio.AI {
-		return NewAIPlayer(symbol, opponentSymbol)
+// ContextPlayer is a player whose move can be abandoned when ctx ends.
+type ContextPlayer interface {
+	ReadMoveContext(ctx context.Context, board boards.Board) (int, error)
+}
+
+// Settler is implemented by adapted players, whose abandoned moves may still
+// be running. AfterSettled calls f once they have finished: at once when
+// none is running, otherwise later from another goroutine, so callers never
+// wait on a read that may not return.
+type Settler interface {
+	AfterSettled(f func())
+}
+
+type contextAdapter struct {
+	player  Player
+	running chan struct{} // closed when the last background ReadMove returns
+}
+
+type moveResult struct {
+	position int
+	err      error
+}
+
+// AdaptContext returns player's own ReadMoveContext when it has one.
+// Otherwise ReadMove runs in the background and is abandoned, not stopped,
+// when ctx ends; its eventual move is discarded. The adapter never runs two
+// reads at once, but an abandoned read can overlap anything else that
+// touches the player, so adapted players must not share mutable state
+// unless it is safe for concurrent use. The next read waits for an abandoned
+// one until its own ctx ends.
+func AdaptContext(player Player) ContextPlayer {
+	if contextPlayer, ok := player.(ContextPlayer); ok {
+		return contextPlayer
+	}
+	return &contextAdapter{player: player}
+}
+
+func (adapter *contextAdapter) AfterSettled(f func()) {
+	running := adapter.running
+	if running == nil {
+		f()
+		return
+	}
+
+	select {
+	case <-running:
+		f()
+	default:
+		go func() {
+			<-running
+			f()
+		}()
+	}
+}
+
+func (adapter *contextAdapter) ReadMoveContext(ctx context.Context, board boards.Board) (int, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+
+	if adapter.running != nil {
+		select {
+		case <-adapter.running:
+		case <-ctx.Done():
+			return 0, ctx.Err()
+		}
 	}
-	return NewHumanPlayer(reader, output)
+
+	if ctx.Done() == nil {
+		return adapter.player.ReadMove(board)
+	}
+
+	result := make(chan moveResult, 1)
+	running := make(chan struct{})
+	adapter.running = running
+	go func() {
+		defer close(running)
+		position, err := adapter.player.ReadMove(board)
+		result <- moveResult{position: position, err: err}
+	}()
+
+	select {
+	case move := <-result:
+		return move.position, move.err
+	case <-ctx.Done():
+		return 0, ctx.Err()
+	}
+}
+
+// Players may implement any of the following to hear about the game beyond
+// their own moves. MoveMade reports every move, the player's own included.
+type GameStartNotifier interface {
+	GameStarted(symbol boards.Cell, board boards.Board)
+}
+
+type MoveObserver interface {
+	MoveMade(player boards.Cell, position int, board boards.Board)
+}
+
+// GameEnded receives boards.InProgress when the game was cut short.
+type GameEndNotifier interface {
+	GameEnded(status boards.GameStatus, board boards.Board)
 }
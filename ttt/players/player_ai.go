@@ -1,7 +1,10 @@
 package players
 
 import (
+	"context"
 	"math"
+	"math/rand"
+	"time"
 	"ttt/boards"
 )
 
@@ -12,18 +15,60 @@ const (
 )
 
 type AIPlayer struct {
-	playerSymbol   string
-	opponentSymbol string
+	playerSymbol   boards.Cell
+	opponentSymbol boards.Cell
+	random         *rand.Rand
+	weights        map[int]float64
+	swindle        bool
 }
 
-func NewAIPlayer(playerSymbol string, opponentSymbol string) *AIPlayer {
-	return &AIPlayer{
+type MoveAnalysis struct {
+	Position      int
+	Score         float64
+	LosingReplies int // opponent replies after which the AI can force a win
+}
+
+type AIOption func(*AIPlayer)
+
+// WithRandom breaks ties between equally good moves with random; a nil
+// random keeps the deterministic first-best choice.
+func WithRandom(random *rand.Rand) AIOption {
+	return func(ai *AIPlayer) {
+		ai.random = random
+	}
+}
+
+// WithMoveWeights biases the choice among equally good moves towards
+// positions with larger weights. Positions without a weight count as 1, so
+// the AI never trades away a better score for style.
+func WithMoveWeights(weights map[int]float64) AIOption {
+	return func(ai *AIPlayer) {
+		ai.weights = weights
+	}
+}
+
+// WithSwindle prefers, among equally good moves, those that leave the
+// opponent the most replies that lose by force.
+func WithSwindle() AIOption {
+	return func(ai *AIPlayer) {
+		ai.swindle = true
+	}
+}
+
+func NewAIPlayer(playerSymbol boards.Cell, opponentSymbol boards.Cell, options ...AIOption) *AIPlayer {
+	ai := &AIPlayer{
 		playerSymbol:   playerSymbol,
 		opponentSymbol: opponentSymbol,
 	}
+
+	for _, option := range options {
+		option(ai)
+	}
+
+	return ai
 }
 
-func (ai *AIPlayer) getTerminalScore(board boards.Board, depth int) (float64, bool) {
+func (ai *AIPlayer) getTerminalScore(board boards.BitBoard, depth int) (float64, bool) {
 	winner := board.CheckWinner()
 
 	if winner == ai.playerSymbol {
@@ -38,18 +83,11 @@ func (ai *AIPlayer) getTerminalScore(board boards.Board, depth int) (float64, bo
 	return DrawScore, isTerminal
 }
 
-func (ai *AIPlayer) evaluateMoveForPlayer(board boards.Board, move int, player string, depth int, isMaximizing bool) float64 {
-	boardCopy := board
-	if err := boardCopy.MakeMove(move, player); err != nil {
-		if isMaximizing {
-			return math.Inf(1)
-		}
-		return math.Inf(-1)
-	}
-	return ai.minimax(boardCopy, depth+1, isMaximizing)
+func (ai *AIPlayer) evaluateMoveForPlayer(board boards.BitBoard, move int, player boards.Cell, depth int, isMaximizing bool) float64 {
+	return ai.minimax(board.Play(move, player), depth+1, isMaximizing)
 }
 
-func (ai *AIPlayer) minimizeScore(board boards.Board, depth int) float64 {
+func (ai *AIPlayer) minimizeScore(board boards.BitBoard, depth int) float64 {
 	minScore := math.Inf(1)
 
 	for _, move := range board.AvailableMoves() {
@@ -60,7 +98,7 @@ func (ai *AIPlayer) minimizeScore(board boards.Board, depth int) float64 {
 	return minScore
 }
 
-func (ai *AIPlayer) maximizeScore(board boards.Board, depth int) float64 {
+func (ai *AIPlayer) maximizeScore(board boards.BitBoard, depth int) float64 {
 	maxScore := math.Inf(-1)
 
 	for _, move := range board.AvailableMoves() {
@@ -71,7 +109,7 @@ func (ai *AIPlayer) maximizeScore(board boards.Board, depth int) float64 {
 	return maxScore
 }
 
-func (ai *AIPlayer) minimax(board boards.Board, depth int, isMaximizing bool) float64 {
+func (ai *AIPlayer) minimax(board boards.BitBoard, depth int, isMaximizing bool) float64 {
 	if score, isTerminal := ai.getTerminalScore(board, depth); isTerminal {
 		return score
 	}
@@ -82,15 +120,15 @@ func (ai *AIPlayer) minimax(board boards.Board, depth int, isMaximizing bool) fl
 	return ai.minimizeScore(board, depth)
 }
 
-func (ai *AIPlayer) evaluateMove(board boards.Board, move int) float64 {
-	boardCopy := board
-	if err := boardCopy.MakeMove(move, ai.playerSymbol); err != nil {
+func (ai *AIPlayer) evaluateMove(board boards.BitBoard, move int) float64 {
+	boardCopy, err := board.MakeMove(move, ai.playerSymbol)
+	if err != nil {
 		return math.Inf(-1)
 	}
 	return ai.minimax(boardCopy, 0, false)
 }
 
-func (ai *AIPlayer) findBestMove(board boards.Board) int {
+func (ai *AIPlayer) findBestMove(board boards.BitBoard) int {
 	bestScore := math.Inf(-1)
 	bestMove := 0
 
@@ -105,7 +143,205 @@ func (ai *AIPlayer) findBestMove(board boards.Board) int {
 	return bestMove
 }
 
+func (ai *AIPlayer) BestMoves(board boards.Board) ([]int, float64) {
+	bitboard := boards.FromBoard(board)
+	bestScore := math.Inf(-1)
+	var bestMoves []int
+
+	for _, move := range bitboard.AvailableMoves() {
+		score := ai.evaluateMove(bitboard, move)
+		if score > bestScore {
+			bestScore = score
+			bestMoves = []int{move}
+		} else if score == bestScore {
+			bestMoves = append(bestMoves, move)
+		}
+	}
+
+	return bestMoves, bestScore
+}
+
+func (ai *AIPlayer) losingReplies(board boards.BitBoard, move int) int {
+	afterMove := board.Play(move, ai.playerSymbol)
+	if afterMove.GetGameStatus() != boards.InProgress {
+		return 0
+	}
+
+	losing := 0
+	for _, reply := range afterMove.AvailableMoves() {
+		afterReply := afterMove.Play(reply, ai.opponentSymbol)
+		if ai.minimax(afterReply, 2, true) > DrawScore {
+			losing++
+		}
+	}
+	return losing
+}
+
+// AnalyzeMoves scores every available move and counts the opponent replies
+// to it that lose by force.
+func (ai *AIPlayer) AnalyzeMoves(board boards.Board) []MoveAnalysis {
+	bitboard := boards.FromBoard(board)
+	var analysis []MoveAnalysis
+	for _, move := range bitboard.AvailableMoves() {
+		analysis = append(analysis, MoveAnalysis{
+			Position:      move,
+			Score:         ai.evaluateMove(bitboard, move),
+			LosingReplies: ai.losingReplies(bitboard, move),
+		})
+	}
+	return analysis
+}
+
+func (ai *AIPlayer) swindleMoves(board boards.Board, bestMoves []int) []int {
+	bitboard := boards.FromBoard(board)
+	mostLosing := -1
+	var traps []int
+	for _, move := range bestMoves {
+		losing := ai.losingReplies(bitboard, move)
+		if losing > mostLosing {
+			mostLosing = losing
+			traps = []int{move}
+		} else if losing == mostLosing {
+			traps = append(traps, move)
+		}
+	}
+	return traps
+}
+
+func (ai *AIPlayer) weight(move int) float64 {
+	if weight, ok := ai.weights[move]; ok {
+		return math.Max(weight, 0)
+	}
+	return 1
+}
+
+// pickMove draws a move with probability proportional to its weight, or
+// takes the heaviest (first on ties) when there is no random source.
+func (ai *AIPlayer) pickMove(moves []int) int {
+	total := 0.0
+	best := moves[0]
+	for _, move := range moves {
+		total += ai.weight(move)
+		if ai.weight(move) > ai.weight(best) {
+			best = move
+		}
+	}
+
+	if ai.random == nil || total == 0 {
+		return best
+	}
+
+	target := ai.random.Float64() * total
+	for _, move := range moves {
+		target -= ai.weight(move)
+		if target < 0 {
+			return move
+		}
+	}
+	return moves[len(moves)-1]
+}
+
 func (ai *AIPlayer) ReadMove(board boards.Board) (int, error) {
-	bestMove := ai.findBestMove(board)
-	return bestMove, nil
+	bitboard := boards.FromBoard(board)
+	if len(bitboard.AvailableMoves()) == 0 {
+		return 0, ErrNoMoves
+	}
+
+	if ai.random == nil && ai.weights == nil && !ai.swindle {
+		return ai.findBestMove(bitboard), nil
+	}
+
+	bestMoves, _ := ai.BestMoves(board)
+
+	if ai.swindle {
+		bestMoves = ai.swindleMoves(board, bestMoves)
+	}
+	return ai.pickMove(bestMoves), nil
+}
+
+// searchMargin is kept back from a deadline so the move arrives in time.
+const (
+	searchMargin     = 5 * time.Millisecond
+	deadlineInterval = 1024
+)
+
+type deadlineSearch struct {
+	deadline time.Time
+	nodes    int
+	expired  bool
+}
+
+func (search *deadlineSearch) timeUp() bool {
+	search.nodes++
+	if search.nodes%deadlineInterval == 0 && time.Now().After(search.deadline) {
+		search.expired = true
+	}
+	return search.expired
+}
+
+// limitedMinimax is minimax cut off at maxDepth, where unfinished lines
+// score as draws, and abandoned once the search runs out of time.
+func (ai *AIPlayer) limitedMinimax(board boards.BitBoard, depth int, maxDepth int, isMaximizing bool, search *deadlineSearch) float64 {
+	if score, isTerminal := ai.getTerminalScore(board, depth); isTerminal {
+		return score
+	}
+
+	if depth >= maxDepth || search.timeUp() {
+		return DrawScore
+	}
+
+	player, best, better := ai.opponentSymbol, math.Inf(1), math.Min
+	if isMaximizing {
+		player, best, better = ai.playerSymbol, math.Inf(-1), math.Max
+	}
+
+	for _, move := range board.AvailableMoves() {
+		best = better(best, ai.limitedMinimax(board.Play(move, player), depth+1, maxDepth, !isMaximizing, search))
+	}
+	return best
+}
+
+func (ai *AIPlayer) bestMovesToDepth(board boards.BitBoard, maxDepth int, search *deadlineSearch) []int {
+	bestScore := math.Inf(-1)
+	var bestMoves []int
+
+	for _, move := range board.AvailableMoves() {
+		score := ai.limitedMinimax(board.Play(move, ai.playerSymbol), 0, maxDepth, false, search)
+		if score > bestScore {
+			bestScore = score
+			bestMoves = []int{move}
+		} else if score == bestScore {
+			bestMoves = append(bestMoves, move)
+		}
+	}
+
+	return bestMoves
+}
+
+// ReadMoveContext treats a deadline on ctx as a time limit: it deepens the
+// search one ply at a time and plays from the deepest search that finished.
+// Swindle mode needs full searches and is skipped under a deadline.
+func (ai *AIPlayer) ReadMoveContext(ctx context.Context, board boards.Board) (int, error) {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return (&contextAdapter{player: ai}).ReadMoveContext(ctx, board)
+	}
+
+	bitboard := boards.FromBoard(board)
+	moves := bitboard.AvailableMoves()
+	if len(moves) == 0 || bitboard.GetGameStatus() != boards.InProgress {
+		return 0, ErrNoMoves
+	}
+
+	search := &deadlineSearch{deadline: deadline.Add(-searchMargin)}
+	bestMoves := moves
+	for maxDepth := 1; maxDepth <= len(moves); maxDepth++ {
+		found := ai.bestMovesToDepth(bitboard, maxDepth, search)
+		if search.expired {
+			break
+		}
+		bestMoves = found
+	}
+
+	return ai.pickMove(bestMoves), nil
 }
@@ -1,12 +1,17 @@
 package players
 
 import (
+	"context"
+	"errors"
+	"math/rand"
+	"slices"
 	"testing"
+	"time"
 	"ttt/boards"
 )
 
 func TestAIPlayer_MakesValidMoveOnEmptyBoard(t *testing.T) {
-	ai := NewAIPlayer("X", "O")
+	ai := NewAIPlayer(boards.PlayerX, boards.PlayerO)
 	board := boards.NewBoard()
 
 	move, err := ai.ReadMove(board)
@@ -16,17 +21,17 @@ func TestAIPlayer_MakesValidMoveOnEmptyBoard(t *testing.T) {
 	}
 
 	boardCopy := board
-	if err := boardCopy.MakeMove(move, "X"); err != nil {
+	if err := boardCopy.MakeMove(move, boards.PlayerX); err != nil {
 		t.Errorf("AI chose invalid move %d: %v", move, err)
 	}
 }
 
 func TestAIPlayer_MakesValidMoveOnPartialBoard(t *testing.T) {
-	ai := NewAIPlayer("X", "O")
+	ai := NewAIPlayer(boards.PlayerX, boards.PlayerO)
 	board := boards.Board{
-		{"X", "O", "3"},
-		{"4", "5", "6"},
-		{"7", "8", "9"},
+		{boards.PlayerX, boards.PlayerO, boards.Empty},
+		{boards.Empty, boards.Empty, boards.Empty},
+		{boards.Empty, boards.Empty, boards.Empty},
 	}
 
 	move, err := ai.ReadMove(board)
@@ -36,17 +41,17 @@ func TestAIPlayer_MakesValidMoveOnPartialBoard(t *testing.T) {
 	}
 
 	boardCopy := board
-	if err := boardCopy.MakeMove(move, "X"); err != nil {
+	if err := boardCopy.MakeMove(move, boards.PlayerX); err != nil {
 		t.Errorf("AI chose invalid move %d: %v", move, err)
 	}
 }
 
 func TestAIPlayer_MakesValidMoveOnNearlyFullBoard(t *testing.T) {
-	ai := NewAIPlayer("X", "O")
+	ai := NewAIPlayer(boards.PlayerX, boards.PlayerO)
 	board := boards.Board{
-		{"X", "O", "X"},
-		{"4", "5", "O"},
-		{"7", "8", "9"},
+		{boards.PlayerX, boards.PlayerO, boards.PlayerX},
+		{boards.Empty, boards.Empty, boards.PlayerO},
+		{boards.Empty, boards.Empty, boards.Empty},
 	}
 
 	move, err := ai.ReadMove(board)
@@ -56,17 +61,17 @@ func TestAIPlayer_MakesValidMoveOnNearlyFullBoard(t *testing.T) {
 	}
 
 	boardCopy := board
-	if err := boardCopy.MakeMove(move, "X"); err != nil {
+	if err := boardCopy.MakeMove(move, boards.PlayerX); err != nil {
 		t.Errorf("AI chose invalid move %d: %v", move, err)
 	}
 }
 
 func TestAIPlayer_MakesOnlyMoveAvailable(t *testing.T) {
-	ai := NewAIPlayer("X", "O")
+	ai := NewAIPlayer(boards.PlayerX, boards.PlayerO)
 	board := boards.Board{
-		{"X", "O", "X"},
-		{"O", "X", "X"},
-		{"X", "O", "9"},
+		{boards.PlayerX, boards.PlayerO, boards.PlayerX},
+		{boards.PlayerO, boards.PlayerX, boards.PlayerX},
+		{boards.PlayerX, boards.PlayerO, boards.Empty},
 	}
 
 	move, err := ai.ReadMove(board)
@@ -81,11 +86,11 @@ func TestAIPlayer_MakesOnlyMoveAvailable(t *testing.T) {
 }
 
 func TestAIPlayer_TakesHorizontalWinTopRow(t *testing.T) {
-	ai := NewAIPlayer("X", "O")
+	ai := NewAIPlayer(boards.PlayerX, boards.PlayerO)
 	board := boards.Board{
-		{"X", "X", "3"},
-		{"O", "O", "6"},
-		{"7", "8", "9"},
+		{boards.PlayerX, boards.PlayerX, boards.Empty},
+		{boards.PlayerO, boards.PlayerO, boards.Empty},
+		{boards.Empty, boards.Empty, boards.Empty},
 	}
 
 	move, err := ai.ReadMove(board)
@@ -99,18 +104,18 @@ func TestAIPlayer_TakesHorizontalWinTopRow(t *testing.T) {
 	}
 
 	boardCopy := board
-	boardCopy.MakeMove(move, "X")
-	if boardCopy.CheckWinner() != "X" {
+	boardCopy.MakeMove(move, boards.PlayerX)
+	if boardCopy.CheckWinner() != boards.PlayerX {
 		t.Error("Move 3 should result in AI winning")
 	}
 }
 
 func TestAIPlayer_TakesVerticalWinLeftColumn(t *testing.T) {
-	ai := NewAIPlayer("X", "O")
+	ai := NewAIPlayer(boards.PlayerX, boards.PlayerO)
 	board := boards.Board{
-		{"X", "2", "3"},
-		{"X", "O", "6"},
-		{"7", "O", "9"},
+		{boards.PlayerX, boards.Empty, boards.Empty},
+		{boards.PlayerX, boards.PlayerO, boards.Empty},
+		{boards.Empty, boards.PlayerO, boards.Empty},
 	}
 
 	move, err := ai.ReadMove(board)
@@ -124,18 +129,18 @@ func TestAIPlayer_TakesVerticalWinLeftColumn(t *testing.T) {
 	}
 
 	boardCopy := board
-	boardCopy.MakeMove(move, "X")
-	if boardCopy.CheckWinner() != "X" {
+	boardCopy.MakeMove(move, boards.PlayerX)
+	if boardCopy.CheckWinner() != boards.PlayerX {
 		t.Error("Move 7 should result in AI winning")
 	}
 }
 
 func TestAIPlayer_TakesDiagonalWin(t *testing.T) {
-	ai := NewAIPlayer("X", "O")
+	ai := NewAIPlayer(boards.PlayerX, boards.PlayerO)
 	board := boards.Board{
-		{"X", "2", "O"},
-		{"4", "X", "O"},
-		{"7", "8", "9"},
+		{boards.PlayerX, boards.Empty, boards.PlayerO},
+		{boards.Empty, boards.PlayerX, boards.PlayerO},
+		{boards.Empty, boards.Empty, boards.Empty},
 	}
 
 	move, err := ai.ReadMove(board)
@@ -149,18 +154,18 @@ func TestAIPlayer_TakesDiagonalWin(t *testing.T) {
 	}
 
 	boardCopy := board
-	boardCopy.MakeMove(move, "X")
-	if boardCopy.CheckWinner() != "X" {
+	boardCopy.MakeMove(move, boards.PlayerX)
+	if boardCopy.CheckWinner() != boards.PlayerX {
 		t.Error("Move 9 should result in AI winning")
 	}
 }
 
 func TestAIPlayer_OPlayerTakesWinningMove(t *testing.T) {
-	ai := NewAIPlayer("O", "X")
+	ai := NewAIPlayer(boards.PlayerO, boards.PlayerX)
 	board := boards.Board{
-		{"O", "O", "3"},
-		{"X", "X", "6"},
-		{"7", "8", "9"},
+		{boards.PlayerO, boards.PlayerO, boards.Empty},
+		{boards.PlayerX, boards.PlayerX, boards.Empty},
+		{boards.Empty, boards.Empty, boards.Empty},
 	}
 
 	move, err := ai.ReadMove(board)
@@ -174,18 +179,18 @@ func TestAIPlayer_OPlayerTakesWinningMove(t *testing.T) {
 	}
 
 	boardCopy := board
-	boardCopy.MakeMove(move, "O")
-	if boardCopy.CheckWinner() != "O" {
+	boardCopy.MakeMove(move, boards.PlayerO)
+	if boardCopy.CheckWinner() != boards.PlayerO {
 		t.Error("Move 3 should result in O winning")
 	}
 }
 
 func TestAIPlayer_BlocksHorizontalThreat(t *testing.T) {
-	ai := NewAIPlayer("X", "O")
+	ai := NewAIPlayer(boards.PlayerX, boards.PlayerO)
 	board := boards.Board{
-		{"O", "O", "3"},
-		{"X", "5", "6"},
-		{"X", "8", "9"},
+		{boards.PlayerO, boards.PlayerO, boards.Empty},
+		{boards.PlayerX, boards.Empty, boards.Empty},
+		{boards.PlayerX, boards.Empty, boards.Empty},
 	}
 
 	move, err := ai.ReadMove(board)
@@ -200,11 +205,11 @@ func TestAIPlayer_BlocksHorizontalThreat(t *testing.T) {
 }
 
 func TestAIPlayer_BlocksVerticalThreat(t *testing.T) {
-	ai := NewAIPlayer("X", "O")
+	ai := NewAIPlayer(boards.PlayerX, boards.PlayerO)
 	board := boards.Board{
-		{"O", "X", "3"},
-		{"O", "5", "6"},
-		{"7", "8", "9"},
+		{boards.PlayerO, boards.PlayerX, boards.Empty},
+		{boards.PlayerO, boards.Empty, boards.Empty},
+		{boards.Empty, boards.Empty, boards.Empty},
 	}
 
 	move, err := ai.ReadMove(board)
@@ -219,11 +224,11 @@ func TestAIPlayer_BlocksVerticalThreat(t *testing.T) {
 }
 
 func TestAIPlayer_BlocksDiagonalThreat(t *testing.T) {
-	ai := NewAIPlayer("X", "O")
+	ai := NewAIPlayer(boards.PlayerX, boards.PlayerO)
 	board := boards.Board{
-		{"O", "2", "3"},
-		{"4", "O", "6"},
-		{"7", "8", "9"},
+		{boards.PlayerO, boards.Empty, boards.Empty},
+		{boards.Empty, boards.PlayerO, boards.Empty},
+		{boards.Empty, boards.Empty, boards.Empty},
 	}
 
 	move, err := ai.ReadMove(board)
@@ -238,11 +243,11 @@ func TestAIPlayer_BlocksDiagonalThreat(t *testing.T) {
 }
 
 func TestAIPlayer_PrioritizesWinOverBlock(t *testing.T) {
-	ai := NewAIPlayer("X", "O")
+	ai := NewAIPlayer(boards.PlayerX, boards.PlayerO)
 	board := boards.Board{
-		{"X", "X", "3"},
-		{"O", "O", "6"},
-		{"7", "8", "9"},
+		{boards.PlayerX, boards.PlayerX, boards.Empty},
+		{boards.PlayerO, boards.PlayerO, boards.Empty},
+		{boards.Empty, boards.Empty, boards.Empty},
 	}
 
 	move, err := ai.ReadMove(board)
@@ -257,7 +262,7 @@ func TestAIPlayer_PrioritizesWinOverBlock(t *testing.T) {
 }
 
 func TestAIPlayer_ChoosesStrategicPositionOnEmptyBoard(t *testing.T) {
-	ai := NewAIPlayer("X", "O")
+	ai := NewAIPlayer(boards.PlayerX, boards.PlayerO)
 	board := boards.NewBoard()
 
 	move, err := ai.ReadMove(board)
@@ -281,11 +286,11 @@ func TestAIPlayer_ChoosesStrategicPositionOnEmptyBoard(t *testing.T) {
 }
 
 func TestAIPlayer_RespondsToCenter(t *testing.T) {
-	ai := NewAIPlayer("X", "O")
+	ai := NewAIPlayer(boards.PlayerX, boards.PlayerO)
 	board := boards.Board{
-		{"1", "2", "3"},
-		{"4", "O", "6"},
-		{"7", "8", "9"},
+		{boards.Empty, boards.Empty, boards.Empty},
+		{boards.Empty, boards.PlayerO, boards.Empty},
+		{boards.Empty, boards.Empty, boards.Empty},
 	}
 
 	move, err := ai.ReadMove(board)
@@ -310,11 +315,11 @@ func TestAIPlayer_RespondsToCenter(t *testing.T) {
 
 func TestAIPlayer_HandlesLastMoveAvailable(t *testing.T) {
 	board := boards.Board{
-		{"X", "2", "O"},
-		{"O", "X", "X"},
-		{"X", "O", "O"},
+		{boards.PlayerX, boards.Empty, boards.PlayerO},
+		{boards.PlayerO, boards.PlayerX, boards.PlayerX},
+		{boards.PlayerX, boards.PlayerO, boards.PlayerO},
 	}
-	ai := NewAIPlayer("X", "O")
+	ai := NewAIPlayer(boards.PlayerX, boards.PlayerO)
 
 	move, err := ai.ReadMove(board)
 
@@ -328,11 +333,11 @@ func TestAIPlayer_HandlesLastMoveAvailable(t *testing.T) {
 }
 
 func TestAIPlayer_HandlesForcedBlockScenario(t *testing.T) {
-	ai := NewAIPlayer("O", "X")
+	ai := NewAIPlayer(boards.PlayerO, boards.PlayerX)
 	board := boards.Board{
-		{"O", "X", "O"},
-		{"X", "X", "6"},
-		{"O", "8", "9"},
+		{boards.PlayerO, boards.PlayerX, boards.PlayerO},
+		{boards.PlayerX, boards.PlayerX, boards.Empty},
+		{boards.PlayerO, boards.Empty, boards.Empty},
 	}
 
 	move, err := ai.ReadMove(board)
@@ -366,7 +371,7 @@ type BoardDepth struct {
 	depth int
 }
 
-func (gameSimulator *GameSimulator) simulateOpponentMoves(boardDepths []BoardDepth, opponentSymbol string) []BoardDepth {
+func (gameSimulator *GameSimulator) simulateOpponentMoves(boardDepths []BoardDepth, opponentSymbol boards.Cell) []BoardDepth {
 	var result []BoardDepth
 
 	for _, boardDepth := range boardDepths {
@@ -423,7 +428,7 @@ func (gameSimulator *GameSimulator) simulateAllGames(
 	unfinished []BoardDepth,
 	finished []BoardDepth,
 	ai *AIPlayer,
-	opponentSymbol string,
+	opponentSymbol boards.Cell,
 ) []BoardDepth {
 	if len(unfinished) == 0 {
 		return finished
@@ -441,10 +446,10 @@ func (gameSimulator *GameSimulator) simulateAllGames(
 	return gameSimulator.simulateAllGames(aiUnfinished, allFinished, ai, opponentSymbol)
 }
 
-func (gameSimulator *GameSimulator) aiNeverLoses(finishedGames []BoardDepth, aiSymbol string) bool {
+func (gameSimulator *GameSimulator) aiNeverLoses(finishedGames []BoardDepth, aiSymbol boards.Cell) bool {
 	for _, boardDepth := range finishedGames {
 		winner := boardDepth.board.CheckWinner()
-		if winner != "" && winner != aiSymbol {
+		if winner != boards.Empty && winner != aiSymbol {
 			return false
 		}
 	}
@@ -457,19 +462,19 @@ func TestAIPlayer_NeverLosesAsSecondPlayer(t *testing.T) {
 	}
 
 	simulator := NewGameSimulator()
-	ai := NewAIPlayer("O", "X")
+	ai := NewAIPlayer(boards.PlayerO, boards.PlayerX)
 	emptyBoard := boards.NewBoard()
 
 	opponentFirstMoves := simulator.simulateOpponentMoves(
 		[]BoardDepth{{board: emptyBoard, depth: 0}},
-		"X",
+		boards.PlayerX,
 	)
 
 	aiResponses := simulator.simulateAIMoves(opponentFirstMoves, ai)
 
-	finishedGames := simulator.simulateAllGames(aiResponses, []BoardDepth{}, ai, "X")
+	finishedGames := simulator.simulateAllGames(aiResponses, []BoardDepth{}, ai, boards.PlayerX)
 
-	if !simulator.aiNeverLoses(finishedGames, "O") {
+	if !simulator.aiNeverLoses(finishedGames, boards.PlayerO) {
 		t.Errorf("AI playing as O should never lose across all %d possible games", len(finishedGames))
 	}
 
@@ -482,17 +487,17 @@ func TestAIPlayer_NeverLosesAsFirstPlayer(t *testing.T) {
 	}
 
 	simulator := NewGameSimulator()
-	ai := NewAIPlayer("X", "O")
+	ai := NewAIPlayer(boards.PlayerX, boards.PlayerO)
 
 	firstMove, _ := ai.ReadMove(boards.NewBoard())
 	firstBoard := boards.NewBoard()
-	firstBoard.MakeMove(firstMove, "X")
+	firstBoard.MakeMove(firstMove, boards.PlayerX)
 
 	startingPositions := []BoardDepth{{board: firstBoard, depth: 1}}
 
-	finishedGames := simulator.simulateAllGames(startingPositions, []BoardDepth{}, ai, "O")
+	finishedGames := simulator.simulateAllGames(startingPositions, []BoardDepth{}, ai, boards.PlayerO)
 
-	if !simulator.aiNeverLoses(finishedGames, "X") {
+	if !simulator.aiNeverLoses(finishedGames, boards.PlayerX) {
 		t.Errorf("AI playing as X should never lose across all %d possible games", len(finishedGames))
 	}
 
@@ -500,17 +505,17 @@ func TestAIPlayer_NeverLosesAsFirstPlayer(t *testing.T) {
 }
 
 func TestAIPlayer_VsAIAlwaysDraws(t *testing.T) {
-	aiX := NewAIPlayer("X", "O")
-	aiO := NewAIPlayer("O", "X")
+	aiX := NewAIPlayer(boards.PlayerX, boards.PlayerO)
+	aiO := NewAIPlayer(boards.PlayerO, boards.PlayerX)
 
 	board := boards.NewBoard()
-	currentPlayer := "X"
+	currentPlayer := boards.PlayerX
 
 	for board.GetGameStatus() == boards.InProgress {
 		var move int
 		var err error
 
-		if currentPlayer == "X" {
+		if currentPlayer == boards.PlayerX {
 			move, err = aiX.ReadMove(board)
 		} else {
 			move, err = aiO.ReadMove(board)
@@ -524,10 +529,10 @@ func TestAIPlayer_VsAIAlwaysDraws(t *testing.T) {
 			t.Fatalf("AI made invalid move %d: %v", move, err)
 		}
 
-		if currentPlayer == "X" {
-			currentPlayer = "O"
+		if currentPlayer == boards.PlayerX {
+			currentPlayer = boards.PlayerO
 		} else {
-			currentPlayer = "X"
+			currentPlayer = boards.PlayerX
 		}
 	}
 
@@ -543,7 +548,7 @@ func TestAIPlayer_RespondsQuickly(t *testing.T) {
 		t.Skip("skipping performance test in short mode")
 	}
 
-	ai := NewAIPlayer("X", "O")
+	ai := NewAIPlayer(boards.PlayerX, boards.PlayerO)
 	board := boards.NewBoard()
 
 	for i := 0; i < 100; i++ {
@@ -553,3 +558,259 @@ func TestAIPlayer_RespondsQuickly(t *testing.T) {
 		}
 	}
 }
+
+func TestAIPlayer_VsAIAlwaysDrawsWhenOMovesFirst(t *testing.T) {
+	aiX := NewAIPlayer(boards.PlayerX, boards.PlayerO)
+	aiO := NewAIPlayer(boards.PlayerO, boards.PlayerX)
+
+	board := boards.NewBoard()
+	currentPlayer := boards.PlayerO
+
+	for board.GetGameStatus() == boards.InProgress {
+		ai := aiX
+		if currentPlayer == boards.PlayerO {
+			ai = aiO
+		}
+
+		move, err := ai.ReadMove(board)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if err := board.MakeMove(move, currentPlayer); err != nil {
+			t.Fatalf("AI made invalid move %d: %v", move, err)
+		}
+
+		if currentPlayer == boards.PlayerX {
+			currentPlayer = boards.PlayerO
+		} else {
+			currentPlayer = boards.PlayerX
+		}
+	}
+
+	if status := board.GetGameStatus(); status != boards.Draw {
+		t.Errorf("Two perfect AIs should draw with O starting, got status: %v", status)
+	}
+}
+
+func TestAIPlayer_RandomTieBreakingVariesOpenings(t *testing.T) {
+	openings := map[int]bool{}
+	board := boards.NewBoard()
+	bestMoves, _ := NewAIPlayer(boards.PlayerX, boards.PlayerO).BestMoves(board)
+
+	for seed := range int64(20) {
+		ai := NewAIPlayer(boards.PlayerX, boards.PlayerO, WithRandom(rand.New(rand.NewSource(seed))))
+		move, _ := ai.ReadMove(board)
+		if !containsMove(bestMoves, move) {
+			t.Fatalf("seed %d: move %d is not among the best moves %v", seed, move, bestMoves)
+		}
+		openings[move] = true
+	}
+
+	if len(openings) < 2 {
+		t.Errorf("expected different seeds to vary the opening, got %v", openings)
+	}
+}
+
+func TestAIPlayer_SameSeedRepeatsGame(t *testing.T) {
+	play := func() []int {
+		x := NewAIPlayer(boards.PlayerX, boards.PlayerO, WithRandom(rand.New(rand.NewSource(7))))
+		o := NewAIPlayer(boards.PlayerO, boards.PlayerX, WithRandom(rand.New(rand.NewSource(8))))
+		board := boards.NewBoard()
+		var moves []int
+		for current := boards.PlayerX; board.GetGameStatus() == boards.InProgress; current = current.Opponent() {
+			player := x
+			if current == boards.PlayerO {
+				player = o
+			}
+			move, _ := player.ReadMove(board)
+			board.MakeMove(move, current)
+			moves = append(moves, move)
+		}
+		return moves
+	}
+
+	first, second := play(), play()
+	if !slices.Equal(first, second) {
+		t.Errorf("same seeds should replay the same game: %v vs %v", first, second)
+	}
+}
+
+func TestAIPlayer_DefaultIsDeterministic(t *testing.T) {
+	ai := NewAIPlayer(boards.PlayerX, boards.PlayerO)
+
+	for range 3 {
+		if move, _ := ai.ReadMove(boards.NewBoard()); move != 1 {
+			t.Errorf("default AI should keep the first best move 1, got %d", move)
+		}
+	}
+}
+
+func TestAIPlayer_MoveWeightsPreferStyle(t *testing.T) {
+	cornersOnly := map[int]float64{1: 1, 3: 1, 7: 1, 9: 1, 2: 0, 4: 0, 5: 0, 6: 0, 8: 0}
+
+	for seed := range int64(10) {
+		ai := NewAIPlayer(boards.PlayerX, boards.PlayerO,
+			WithRandom(rand.New(rand.NewSource(seed))), WithMoveWeights(cornersOnly))
+		move, _ := ai.ReadMove(boards.NewBoard())
+		if move != 1 && move != 3 && move != 7 && move != 9 {
+			t.Errorf("seed %d: expected a corner opening, got %d", seed, move)
+		}
+	}
+
+	centerFirst := NewAIPlayer(boards.PlayerX, boards.PlayerO, WithMoveWeights(map[int]float64{5: 3}))
+	if move, _ := centerFirst.ReadMove(boards.NewBoard()); move != 5 {
+		t.Errorf("without a random source the heaviest best move should win, got %d", move)
+	}
+}
+
+func TestAIPlayer_WeightsNeverOverrideScore(t *testing.T) {
+	ai := NewAIPlayer(boards.PlayerX, boards.PlayerO, WithMoveWeights(map[int]float64{3: 0, 9: 100}))
+	board := boards.Board{
+		{boards.PlayerX, boards.PlayerX, boards.Empty},
+		{boards.PlayerO, boards.PlayerO, boards.Empty},
+		{boards.Empty, boards.Empty, boards.Empty},
+	}
+
+	if move, _ := ai.ReadMove(board); move != 3 {
+		t.Errorf("should still take the win at 3, got %d", move)
+	}
+}
+
+func TestAIPlayer_RandomTieBreakingNeverLoses(t *testing.T) {
+	for _, symbol := range []boards.Cell{boards.PlayerX, boards.PlayerO} {
+		ai := NewAIPlayer(symbol, symbol.Opponent(), WithRandom(rand.New(rand.NewSource(1))))
+
+		exhaustNeverLoses(t, ai, symbol, boards.NewBoard(), boards.PlayerX)
+		exhaustNeverLoses(t, ai, symbol, boards.NewBoard(), boards.PlayerO)
+	}
+}
+
+func TestAIPlayer_AnalyzeMovesCountsLosingReplies(t *testing.T) {
+	analysis := NewAIPlayer(boards.PlayerX, boards.PlayerO).AnalyzeMoves(boards.NewBoard())
+
+	losing := map[int]int{}
+	for _, move := range analysis {
+		if move.Score != DrawScore {
+			t.Errorf("every opening should draw, %d scores %v", move.Position, move.Score)
+		}
+		losing[move.Position] = move.LosingReplies
+	}
+
+	// After a corner only the center holds; after the center only corners do.
+	if losing[1] != 7 || losing[5] != 4 {
+		t.Errorf("expected 7 losing replies to a corner and 4 to the center, got %v", losing)
+	}
+}
+
+func TestAIPlayer_SwindlePicksTheTrappiest(t *testing.T) {
+	for _, symbol := range []boards.Cell{boards.PlayerX, boards.PlayerO} {
+		ai := NewAIPlayer(symbol, symbol.Opponent(), WithSwindle())
+		swindleChecks(t, ai, symbol, boards.NewBoard(), boards.PlayerX)
+	}
+}
+
+func swindleChecks(t *testing.T, ai *AIPlayer, symbol boards.Cell, board boards.Board, toMove boards.Cell) {
+	t.Helper()
+
+	if board.GetGameStatus() != boards.InProgress {
+		return
+	}
+
+	if toMove == symbol {
+		move, _ := ai.ReadMove(board)
+		_, bestScore := ai.BestMoves(board)
+
+		var chosen MoveAnalysis
+		mostLosing := 0
+		for _, analysis := range ai.AnalyzeMoves(board) {
+			if analysis.Position == move {
+				chosen = analysis
+			}
+			if analysis.Score == bestScore {
+				mostLosing = max(mostLosing, analysis.LosingReplies)
+			}
+		}
+
+		if chosen.Score != bestScore || chosen.LosingReplies != mostLosing {
+			t.Fatalf("board %v: swindle move %d (%+v) is not the trappiest best move (score %v, %d losing)",
+				board, move, chosen, bestScore, mostLosing)
+		}
+
+		board.MakeMove(move, symbol)
+		swindleChecks(t, ai, symbol, board, toMove.Opponent())
+		return
+	}
+
+	for _, reply := range board.AvailableMoves() {
+		next := board
+		next.MakeMove(reply, toMove)
+		swindleChecks(t, ai, symbol, next, toMove.Opponent())
+	}
+}
+
+func TestAIPlayer_SwindleNeverLoses(t *testing.T) {
+	for _, symbol := range []boards.Cell{boards.PlayerX, boards.PlayerO} {
+		ai := NewAIPlayer(symbol, symbol.Opponent(), WithSwindle(), WithRandom(rand.New(rand.NewSource(3))))
+
+		exhaustNeverLoses(t, ai, symbol, boards.NewBoard(), boards.PlayerX)
+		exhaustNeverLoses(t, ai, symbol, boards.NewBoard(), boards.PlayerO)
+	}
+}
+
+func BenchmarkAIPlayer_EmptyBoard(b *testing.B) {
+	ai := NewAIPlayer(boards.PlayerX, boards.PlayerO)
+	board := boards.NewBoard()
+
+	for b.Loop() {
+		ai.ReadMove(board)
+	}
+}
+
+func TestAIPlayer_DeadlineReturnsInTime(t *testing.T) {
+	ai := NewAIPlayer(boards.PlayerX, boards.PlayerO)
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	move, err := ai.ReadMoveContext(ctx, boards.NewBoard())
+
+	if err != nil || !boards.NewBoard().IsPositionValid(move) {
+		t.Fatalf("expected a legal move, got %d (%v)", move, err)
+	}
+	if elapsed := time.Since(start); elapsed > 50*time.Millisecond {
+		t.Errorf("move took %v, past the 50ms deadline", elapsed)
+	}
+}
+
+func TestAIPlayer_DeadlineSearchStillFindsWin(t *testing.T) {
+	ai := NewAIPlayer(boards.PlayerO, boards.PlayerX)
+	board := boards.Board{
+		{boards.PlayerX, boards.PlayerX, boards.Empty},
+		{boards.PlayerO, boards.PlayerO, boards.Empty},
+		{boards.PlayerX, boards.Empty, boards.Empty},
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	if move, _ := ai.ReadMoveContext(ctx, board); move != 6 {
+		t.Errorf("expected the win at 6, got %d", move)
+	}
+}
+
+func TestAIPlayer_FullBoardHasNoMoves(t *testing.T) {
+	board := boards.Board{
+		{boards.PlayerX, boards.PlayerO, boards.PlayerX},
+		{boards.PlayerX, boards.PlayerO, boards.PlayerO},
+		{boards.PlayerO, boards.PlayerX, boards.PlayerX},
+	}
+
+	for _, ai := range []*AIPlayer{
+		NewAIPlayer(boards.PlayerX, boards.PlayerO),
+		NewAIPlayer(boards.PlayerX, boards.PlayerO, WithRandom(rand.New(rand.NewSource(1)))),
+	} {
+		if _, err := ai.ReadMove(board); !errors.Is(err, ErrNoMoves) {
+			t.Errorf("full board should give ErrNoMoves, got %v", err)
+		}
+	}
+}
@@ -2,6 +2,7 @@ package players
 
 import (
 	"bufio"
+	"context"
 	"errors"
 	"io"
 	"strconv"
@@ -11,8 +12,14 @@ import (
 )
 
 type HumanPlayer struct {
-	reader *bufio.Reader
-	output io.Writer
+	reader  *bufio.Reader
+	output  io.Writer
+	pending chan lineResult
+}
+
+type lineResult struct {
+	line string
+	err  error
 }
 
 func NewHumanPlayer(reader *bufio.Reader, output io.Writer) *HumanPlayer {
@@ -52,10 +59,48 @@ func (humanPlayer *HumanPlayer) parseInput(input string) (int, error) {
 	return position, nil
 }
 
-func (humanPlayer *HumanPlayer) getValidPosition() (int, error) {
+// readLine waits for the next line or for ctx to end. A read that is still
+// blocked when ctx ends stays pending, and its line goes to the next call
+// instead of being lost.
+func (humanPlayer *HumanPlayer) readLine(ctx context.Context) (string, error) {
+	if humanPlayer.pending == nil && ctx.Done() == nil {
+		return humanPlayer.reader.ReadString('\n')
+	}
+
+	if humanPlayer.pending == nil {
+		pending := make(chan lineResult, 1)
+		humanPlayer.pending = pending
+		go func() {
+			line, err := humanPlayer.reader.ReadString('\n')
+			pending <- lineResult{line: line, err: err}
+		}()
+	}
+
+	select {
+	case result := <-humanPlayer.pending:
+		humanPlayer.pending = nil
+		return result.line, result.err
+	case <-ctx.Done():
+		return "", ctx.Err()
+	}
+}
+
+// TakePending waits for a read left behind by a cancelled move and returns
+// its line, so the line can be handed to whoever reads the input next.
+func (humanPlayer *HumanPlayer) TakePending() (string, bool) {
+	if humanPlayer.pending == nil {
+		return "", false
+	}
+
+	result := <-humanPlayer.pending
+	humanPlayer.pending = nil
+	return result.line, result.err == nil
+}
+
+func (humanPlayer *HumanPlayer) getValidPosition(ctx context.Context) (int, error) {
 	for {
 		tttio.ShowPrompt(humanPlayer.output)
-		line, err := humanPlayer.reader.ReadString('\n')
+		line, err := humanPlayer.readLine(ctx)
 		if err != nil {
 			return 0, err
 		}
@@ -71,8 +116,12 @@ func (humanPlayer *HumanPlayer) getValidPosition() (int, error) {
 }
 
 func (humanPlayer *HumanPlayer) ReadMove(board boards.Board) (int, error) {
+	return humanPlayer.ReadMoveContext(context.Background(), board)
+}
+
+func (humanPlayer *HumanPlayer) ReadMoveContext(ctx context.Context, board boards.Board) (int, error) {
 	for {
-		position, err := humanPlayer.getValidPosition()
+		position, err := humanPlayer.getValidPosition(ctx)
 		if err != nil {
 			return 0, err
 		}
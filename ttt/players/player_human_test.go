@@ -3,8 +3,12 @@ package players
 import (
 	"bufio"
 	"bytes"
+	"context"
+	"errors"
+	"io"
 	"strings"
 	"testing"
+	"time"
 	"ttt/boards"
 )
 
@@ -266,7 +270,7 @@ func TestHumanPlayer_HandlesMultipleDifferentInvalidInputs(t *testing.T) {
 
 func TestHumanPlayer_RejectsOccupiedPositionByX(t *testing.T) {
 	board := boards.NewBoard()
-	board.MakeMove(5, "X")
+	board.MakeMove(5, boards.PlayerX)
 	input := "5\n7\n"
 	var output bytes.Buffer
 	human := NewHumanPlayer(bufio.NewReader(strings.NewReader(input)), &output)
@@ -289,7 +293,7 @@ func TestHumanPlayer_RejectsOccupiedPositionByX(t *testing.T) {
 
 func TestHumanPlayer_RejectsOccupiedPositionByO(t *testing.T) {
 	board := boards.NewBoard()
-	board.MakeMove(1, "O")
+	board.MakeMove(1, boards.PlayerO)
 	input := "1\n2\n"
 	var output bytes.Buffer
 	human := NewHumanPlayer(bufio.NewReader(strings.NewReader(input)), &output)
@@ -312,7 +316,7 @@ func TestHumanPlayer_RejectsOccupiedPositionByO(t *testing.T) {
 
 func TestHumanPlayer_ValidMove(t *testing.T) {
 	board := boards.NewBoard()
-	board.MakeMove(5, "X")
+	board.MakeMove(5, boards.PlayerX)
 	input := "6\n"
 	var output bytes.Buffer
 	human := NewHumanPlayer(bufio.NewReader(strings.NewReader(input)), &output)
@@ -368,7 +372,7 @@ func TestHumanPlayer_DisplaysPromptOnEachRetry(t *testing.T) {
 
 func TestHumanPlayer_HandlesMultipleBadInput(t *testing.T) {
 	board := boards.NewBoard()
-	board.MakeMove(1, "X")
+	board.MakeMove(1, boards.PlayerX)
 	input := "\nabc\n10\n1\n5\n"
 	var output bytes.Buffer
 	human := NewHumanPlayer(bufio.NewReader(strings.NewReader(input)), &output)
@@ -394,9 +398,9 @@ func TestHumanPlayer_HandlesMultipleBadInput(t *testing.T) {
 
 func TestHumanPlayer_HandlesMultipleOccupiedAttempts(t *testing.T) {
 	board := boards.NewBoard()
-	board.MakeMove(1, "X")
-	board.MakeMove(2, "O")
-	board.MakeMove(3, "X")
+	board.MakeMove(1, boards.PlayerX)
+	board.MakeMove(2, boards.PlayerO)
+	board.MakeMove(3, boards.PlayerX)
 	input := "1\n2\n3\n5\n"
 	var output bytes.Buffer
 	human := NewHumanPlayer(bufio.NewReader(strings.NewReader(input)), &output)
@@ -420,7 +424,7 @@ func TestHumanPlayer_HandlesMultipleOccupiedAttempts(t *testing.T) {
 
 func TestHumanPlayer_HandlesAlternatingInvalidAndOccupied(t *testing.T) {
 	board := boards.NewBoard()
-	board.MakeMove(5, "X")
+	board.MakeMove(5, boards.PlayerX)
 	input := "abc\n5\n0\n7\n"
 	var output bytes.Buffer
 	human := NewHumanPlayer(bufio.NewReader(strings.NewReader(input)), &output)
@@ -446,9 +450,9 @@ func TestHumanPlayer_HandlesAlternatingInvalidAndOccupied(t *testing.T) {
 
 func TestHumanPlayer_AcceptsLastAvailablePosition(t *testing.T) {
 	board := boards.Board{
-		{"X", "O", "X"},
-		{"O", "X", "O"},
-		{"X", "O", "9"},
+		{boards.PlayerX, boards.PlayerO, boards.PlayerX},
+		{boards.PlayerO, boards.PlayerX, boards.PlayerO},
+		{boards.PlayerX, boards.PlayerO, boards.Empty},
 	}
 	input := "9\n"
 	var output bytes.Buffer
@@ -467,9 +471,9 @@ func TestHumanPlayer_AcceptsLastAvailablePosition(t *testing.T) {
 
 func TestHumanPlayer_AllOccupiedPositionsBeforeAcceptingValid(t *testing.T) {
 	board := boards.Board{
-		{"X", "O", "X"},
-		{"O", "X", "O"},
-		{"X", "O", "9"},
+		{boards.PlayerX, boards.PlayerO, boards.PlayerX},
+		{boards.PlayerO, boards.PlayerX, boards.PlayerO},
+		{boards.PlayerX, boards.PlayerO, boards.Empty},
 	}
 	input := "1\n2\n3\n4\n5\n6\n7\n8\n9\n"
 	var output bytes.Buffer
@@ -491,3 +495,24 @@ func TestHumanPlayer_AllOccupiedPositionsBeforeAcceptingValid(t *testing.T) {
 		t.Errorf("should reject 8 occupied positions, got %d rejections", rejectionCount)
 	}
 }
+
+func TestHumanPlayer_ReadMoveContextReturnsOnCancel(t *testing.T) {
+	reader, writer := io.Pipe()
+	defer writer.Close()
+	human := NewHumanPlayer(bufio.NewReader(reader), io.Discard)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, err := human.ReadMoveContext(ctx, boards.NewBoard())
+
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected the deadline error, got %v", err)
+	}
+
+	go writer.Write([]byte("7\n"))
+	got, err := human.ReadMoveContext(context.Background(), boards.NewBoard())
+	if err != nil || got != 7 {
+		t.Errorf("the pending line should go to the next read, got %d (%v)", got, err)
+	}
+}
@@ -0,0 +1,82 @@
+package players
+
+import (
+	"math/rand"
+	"time"
+	"ttt/boards"
+	"ttt/learning"
+)
+
+type learningStep struct {
+	box      *learning.Box
+	position int // in the normalised board
+}
+
+// LearningPlayer is a MENACE matchbox player. It remembers the boxes and
+// beads behind each move of the current game until Learn is called.
+type LearningPlayer struct {
+	playerSymbol boards.Cell
+	table        *learning.Table
+	random       *rand.Rand
+	history      []learningStep
+}
+
+// NewLearningPlayer draws beads with random, or with its own clock-seeded
+// source when random is nil.
+func NewLearningPlayer(playerSymbol boards.Cell, table *learning.Table, random *rand.Rand) *LearningPlayer {
+	if random == nil {
+		random = rand.New(rand.NewSource(time.Now().UnixNano()))
+	}
+
+	return &LearningPlayer{
+		playerSymbol: playerSymbol,
+		table:        table,
+		random:       random,
+	}
+}
+
+// normalise shows the board as if this player were X, in canonical form.
+func (learningPlayer *LearningPlayer) normalise(board boards.Board) (boards.Board, boards.Transform) {
+	if learningPlayer.playerSymbol == boards.PlayerO {
+		board = board.SwapColors()
+	}
+	return board.Canonical()
+}
+
+func (learningPlayer *LearningPlayer) ReadMove(board boards.Board) (int, error) {
+	if board.GetGameStatus() != boards.InProgress {
+		return 0, ErrNoMoves
+	}
+
+	normalised, transform := learningPlayer.normalise(board)
+	box := learningPlayer.table.Lookup(normalised)
+	position := box.Draw(normalised, learningPlayer.random)
+
+	learningPlayer.history = append(learningPlayer.history, learningStep{box: box, position: position})
+	return transform.Inverse().MapPosition(position), nil
+}
+
+// Learn rewards or punishes every move of the finished game and starts a
+// fresh history for the next one.
+func (learningPlayer *LearningPlayer) Learn(status boards.GameStatus) learning.Outcome {
+	outcome := learning.OutcomeFor(status, learningPlayer.playerSymbol)
+	for _, step := range learningPlayer.history {
+		step.box.Reward(step.position, outcome)
+	}
+	learningPlayer.history = nil
+	return outcome
+}
+
+func (learningPlayer *LearningPlayer) GameStarted(symbol boards.Cell, board boards.Board) {
+	learningPlayer.history = nil
+}
+
+// GameEnded learns from every finished game; an interrupted game teaches
+// nothing.
+func (learningPlayer *LearningPlayer) GameEnded(status boards.GameStatus, board boards.Board) {
+	if status == boards.InProgress {
+		learningPlayer.history = nil
+		return
+	}
+	learningPlayer.Learn(status)
+}
@@ -0,0 +1,65 @@
+package players
+
+import (
+	"math/rand"
+	"testing"
+	"ttt/boards"
+	"ttt/learning"
+)
+
+func TestLearningPlayer_PlaysLegalMovesOnBothSides(t *testing.T) {
+	table := learning.NewTable()
+	random := rand.New(rand.NewSource(1))
+
+	for range 50 {
+		board := boards.NewBoard()
+		learners := map[boards.Cell]*LearningPlayer{
+			boards.PlayerX: NewLearningPlayer(boards.PlayerX, table, random),
+			boards.PlayerO: NewLearningPlayer(boards.PlayerO, table, random),
+		}
+
+		for current := boards.PlayerX; board.GetGameStatus() == boards.InProgress; current = current.Opponent() {
+			move, err := learners[current].ReadMove(board)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if err := board.MakeMove(move, current); err != nil {
+				t.Fatalf("illegal move %d on %v: %v", move, board, err)
+			}
+		}
+	}
+}
+
+func TestLearningPlayer_LearnRewardsPlayedMoves(t *testing.T) {
+	table := learning.NewTable()
+	player := NewLearningPlayer(boards.PlayerO, table, rand.New(rand.NewSource(1)))
+	board := boards.NewBoard()
+	board.MakeMove(5, boards.PlayerX)
+
+	move, _ := player.ReadMove(board)
+	outcome := player.Learn(boards.OWins)
+
+	if outcome != learning.Won {
+		t.Fatalf("O should have won, got %s", outcome)
+	}
+
+	normalised, transform := board.SwapColors().Canonical()
+	box := table.Lookup(normalised)
+	if beads := box[transform.MapPosition(move)-boards.MinPosition]; beads != learning.InitialBeads+learning.WinBeads {
+		t.Errorf("the played move should hold %d beads, got %d", learning.InitialBeads+learning.WinBeads, beads)
+	}
+
+	if player.Learn(boards.XWins); box[transform.MapPosition(move)-boards.MinPosition] != learning.InitialBeads+learning.WinBeads {
+		t.Error("Learn should forget the history once it has been applied")
+	}
+}
+
+func TestLearningPlayer_NilRandomStillDraws(t *testing.T) {
+	player := NewLearningPlayer(boards.PlayerX, learning.NewTable(), nil)
+
+	move, err := player.ReadMove(boards.NewBoard())
+
+	if err != nil || !boards.NewBoard().IsPositionValid(move) {
+		t.Errorf("should draw a legal move without a random source, got %d %v", move, err)
+	}
+}
@@ -0,0 +1,64 @@
+package players
+
+import (
+	"errors"
+	"fmt"
+	"math/bits"
+	"ttt/boards"
+	"ttt/retrograde"
+)
+
+// RetrogradePlayer plays from a retrograde table of any size, searching
+// forward to the table from positions it does not cover.
+type RetrogradePlayer struct {
+	playerSymbol boards.Cell
+	table        *retrograde.Table
+}
+
+var ErrTableSize = errors.New("retrograde table does not match the board size")
+
+func NewRetrogradePlayer(playerSymbol boards.Cell, table *retrograde.Table) *RetrogradePlayer {
+	return &RetrogradePlayer{
+		playerSymbol: playerSymbol,
+		table:        table,
+	}
+}
+
+// BestCell picks a move on the table's board, given as masks of the stones
+// of the side that moved first (x) and the other side (o). The cell is a
+// bit index, row-major from the top left.
+func (retrogradePlayer *RetrogradePlayer) BestCell(x uint32, o uint32) (int, error) {
+	cell, _, ok := retrogradePlayer.table.Search(x, o)
+	if !ok {
+		return 0, ErrNoMoves
+	}
+	return cell, nil
+}
+
+// tablePosition returns the board as X and O masks in the table's layout,
+// swapping colours when O moved first since the table assumes X did.
+func (retrogradePlayer *RetrogradePlayer) tablePosition(board boards.Board) (uint32, uint32) {
+	bitboard := boards.FromBoard(board)
+	x, o := uint32(bitboard.X), uint32(bitboard.O)
+
+	xToMove := bits.OnesCount32(x) == bits.OnesCount32(o)
+	if xToMove != (retrogradePlayer.playerSymbol == boards.PlayerX) {
+		x, o = o, x
+	}
+	return x, o
+}
+
+// ReadMove plays on the game's board, which only a table of the same size
+// can describe.
+func (retrogradePlayer *RetrogradePlayer) ReadMove(board boards.Board) (int, error) {
+	if size := retrogradePlayer.table.Size; size != boards.BoardSize {
+		return 0, fmt.Errorf("%w: table is %dx%d, board is %dx%d",
+			ErrTableSize, size, size, boards.BoardSize, boards.BoardSize)
+	}
+
+	cell, err := retrogradePlayer.BestCell(retrogradePlayer.tablePosition(board))
+	if err != nil {
+		return 0, err
+	}
+	return cell + boards.MinPosition, nil
+}
@@ -0,0 +1,96 @@
+package players
+
+import (
+	"errors"
+	"testing"
+	"ttt/boards"
+	"ttt/retrograde"
+)
+
+func TestRetrogradePlayer_SearchesBeforeTableCoverage(t *testing.T) {
+	table, _ := retrograde.Build(3, 5, nil)
+	player := NewRetrogradePlayer(boards.PlayerO, table)
+	board := boards.NewBoard()
+	board.MakeMove(1, boards.PlayerX)
+
+	move, err := player.ReadMove(board)
+
+	if err != nil || move != 5 {
+		t.Errorf("O should search its way to the centre (5), got %d %v", move, err)
+	}
+}
+
+func TestRetrogradePlayer_UsesTableWhenCovered(t *testing.T) {
+	table, _ := retrograde.Build(3, 4, nil)
+	player := NewRetrogradePlayer(boards.PlayerX, table)
+	board := boards.Board{
+		{boards.PlayerX, boards.PlayerX, boards.Empty},
+		{boards.PlayerO, boards.PlayerO, boards.Empty},
+		{boards.Empty, boards.Empty, boards.Empty},
+	}
+
+	move, _ := player.ReadMove(board)
+
+	if move != 3 {
+		t.Errorf("should win at 3, got %d", move)
+	}
+}
+
+func TestRetrogradePlayer_HandlesOMovingFirst(t *testing.T) {
+	table, _ := retrograde.Build(3, 0, nil)
+	player := NewRetrogradePlayer(boards.PlayerX, table)
+	board := boards.Board{
+		{boards.PlayerO, boards.PlayerO, boards.Empty},
+		{boards.PlayerX, boards.Empty, boards.Empty},
+		{boards.Empty, boards.Empty, boards.Empty},
+	}
+
+	move, err := player.ReadMove(board)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if move != 3 {
+		t.Errorf("X should block O at 3, got %d", move)
+	}
+}
+
+func TestRetrogradePlayer_NeverLoses(t *testing.T) {
+	table, _ := retrograde.Build(3, 0, nil)
+
+	for _, symbol := range []boards.Cell{boards.PlayerX, boards.PlayerO} {
+		player := NewRetrogradePlayer(symbol, table)
+
+		exhaustNeverLoses(t, player, symbol, boards.NewBoard(), boards.PlayerX)
+		exhaustNeverLoses(t, player, symbol, boards.NewBoard(), boards.PlayerO)
+	}
+}
+
+func TestRetrogradePlayer_PlaysLargerBoards(t *testing.T) {
+	table, _ := retrograde.Build(4, 14, nil)
+	player := NewRetrogradePlayer(boards.PlayerX, table)
+	// XXX.
+	// OOXO
+	// OXO.
+	// O.X.
+	x := uint32(0b0100_0010_0100_0111)
+	o := uint32(0b0001_0101_1011_0000)
+
+	cell, err := player.BestCell(x, o)
+
+	if err != nil || cell != 3 {
+		t.Errorf("12-stone position should be searched to the win at cell 3, got %d %v", cell, err)
+	}
+}
+
+func TestRetrogradePlayer_ReadMoveNeedsBoardSizedTable(t *testing.T) {
+	table, _ := retrograde.Build(4, 14, nil)
+	player := NewRetrogradePlayer(boards.PlayerX, table)
+
+	_, err := player.ReadMove(boards.NewBoard())
+
+	if !errors.Is(err, ErrTableSize) {
+		t.Errorf("a 4x4 table cannot play the 3x3 board, got %v", err)
+	}
+}
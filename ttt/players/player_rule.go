@@ -0,0 +1,116 @@
+package players
+
+import (
+	"errors"
+	"ttt/boards"
+)
+
+// Rule is one step of the Newell–Simon strategy, listed in priority order.
+type Rule int
+
+const (
+	RuleWin Rule = iota
+	RuleBlock
+	RuleFork
+	RuleBlockFork
+	RuleCenter
+	RuleOppositeCorner
+	RuleEmptyCorner
+	RuleEmptySide
+)
+
+const centerPosition = 5
+
+var (
+	ErrNoMoves = errors.New("no moves available")
+
+	corners         = []int{1, 3, 7, 9}
+	sides           = []int{2, 4, 6, 8}
+	oppositeCorners = map[int]int{1: 9, 3: 7, 7: 3, 9: 1}
+)
+
+func (rule Rule) String() string {
+	switch rule {
+	case RuleWin:
+		return "win"
+	case RuleBlock:
+		return "block"
+	case RuleFork:
+		return "fork"
+	case RuleBlockFork:
+		return "block fork"
+	case RuleCenter:
+		return "center"
+	case RuleOppositeCorner:
+		return "opposite corner"
+	case RuleEmptyCorner:
+		return "empty corner"
+	default:
+		return "empty side"
+	}
+}
+
+type RulePlayer struct {
+	playerSymbol boards.Cell
+	lastRule     Rule
+}
+
+func NewRulePlayer(playerSymbol boards.Cell) *RulePlayer {
+	return &RulePlayer{playerSymbol: playerSymbol}
+}
+
+// ChooseMove applies the rules in order and returns the first move found
+// together with the rule that produced it.
+func (rulePlayer *RulePlayer) ChooseMove(board boards.Board) (int, Rule, error) {
+	symbol := rulePlayer.playerSymbol
+	opponent := symbol.Opponent()
+
+	candidates := []struct {
+		rule  Rule
+		moves []int
+	}{
+		{RuleWin, board.WinningMoves(symbol)},
+		{RuleBlock, board.BlockingMoves(symbol)},
+		{RuleFork, board.ForkMoves(symbol)},
+		{RuleBlockFork, board.ForkBlockingMoves(symbol)},
+		{RuleCenter, []int{centerPosition}},
+		{RuleOppositeCorner, opposite(board, opponent)},
+		{RuleEmptyCorner, corners},
+		{RuleEmptySide, sides},
+	}
+
+	for _, candidate := range candidates {
+		for _, move := range candidate.moves {
+			if board.IsPositionValid(move) {
+				return move, candidate.rule, nil
+			}
+		}
+	}
+
+	return 0, 0, ErrNoMoves
+}
+
+func opposite(board boards.Board, opponent boards.Cell) []int {
+	var moves []int
+	for _, corner := range corners {
+		if board.At(corner) == opponent {
+			moves = append(moves, oppositeCorners[corner])
+		}
+	}
+	return moves
+}
+
+// LastRule reports the rule behind the most recent move from ReadMove.
+func (rulePlayer *RulePlayer) LastRule() Rule {
+	return rulePlayer.lastRule
+}
+
+func (rulePlayer *RulePlayer) ReadMove(board boards.Board) (int, error) {
+	move, rule, err := rulePlayer.ChooseMove(board)
+	if err != nil {
+		return 0, err
+	}
+
+	rulePlayer.lastRule = rule
+	return move, nil
+}
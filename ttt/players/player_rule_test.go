@@ -0,0 +1,165 @@
+package players
+
+import (
+	"errors"
+	"testing"
+	"ttt/boards"
+)
+
+func TestRulePlayer_ReportsTriggeredRule(t *testing.T) {
+	tests := []struct {
+		name  string
+		board boards.Board
+		move  int
+		rule  Rule
+	}{
+		{
+			name: "win before block",
+			board: boards.Board{
+				{boards.PlayerX, boards.PlayerX, boards.Empty},
+				{boards.PlayerO, boards.PlayerO, boards.Empty},
+				{boards.Empty, boards.Empty, boards.Empty},
+			},
+			move: 3,
+			rule: RuleWin,
+		},
+		{
+			name: "block",
+			board: boards.Board{
+				{boards.PlayerO, boards.PlayerO, boards.Empty},
+				{boards.Empty, boards.PlayerX, boards.Empty},
+				{boards.Empty, boards.Empty, boards.Empty},
+			},
+			move: 3,
+			rule: RuleBlock,
+		},
+		{
+			name: "fork",
+			board: boards.Board{
+				{boards.PlayerX, boards.Empty, boards.Empty},
+				{boards.Empty, boards.PlayerO, boards.Empty},
+				{boards.Empty, boards.Empty, boards.PlayerX},
+			},
+			move: 3,
+			rule: RuleFork,
+		},
+		{
+			name: "center",
+			board: boards.Board{
+				{boards.PlayerO, boards.Empty, boards.Empty},
+				{boards.Empty, boards.Empty, boards.Empty},
+				{boards.Empty, boards.Empty, boards.Empty},
+			},
+			move: 5,
+			rule: RuleCenter,
+		},
+		{
+			name:  "empty corner",
+			board: boards.NewBoard(),
+			move:  5,
+			rule:  RuleCenter,
+		},
+	}
+
+	for _, test := range tests {
+		player := NewRulePlayer(boards.PlayerX)
+
+		move, err := player.ReadMove(test.board)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", test.name, err)
+		}
+
+		if move != test.move || player.LastRule() != test.rule {
+			t.Errorf("%s: expected %d by %s, got %d by %s", test.name, test.move, test.rule, move, player.LastRule())
+		}
+	}
+}
+
+func TestRulePlayer_BlocksForkWithForcingEdge(t *testing.T) {
+	player := NewRulePlayer(boards.PlayerO)
+	board := boards.Board{
+		{boards.PlayerX, boards.Empty, boards.Empty},
+		{boards.Empty, boards.PlayerO, boards.Empty},
+		{boards.Empty, boards.Empty, boards.PlayerX},
+	}
+
+	move, rule, _ := player.ChooseMove(board)
+
+	if rule != RuleBlockFork || move != 2 {
+		t.Errorf("expected 2 by block fork, got %d by %s", move, rule)
+	}
+}
+
+func TestRulePlayer_TakesOppositeCorner(t *testing.T) {
+	player := NewRulePlayer(boards.PlayerX)
+	board := boards.Board{
+		{boards.Empty, boards.Empty, boards.PlayerO},
+		{boards.Empty, boards.PlayerX, boards.Empty},
+		{boards.Empty, boards.Empty, boards.Empty},
+	}
+
+	move, rule, _ := player.ChooseMove(board)
+
+	if rule != RuleOppositeCorner || move != 7 {
+		t.Errorf("expected 7 by opposite corner, got %d by %s", move, rule)
+	}
+}
+
+func TestRulePlayer_FullBoard(t *testing.T) {
+	board := boards.Board{
+		{boards.PlayerX, boards.PlayerO, boards.PlayerX},
+		{boards.PlayerX, boards.PlayerO, boards.PlayerO},
+		{boards.PlayerO, boards.PlayerX, boards.PlayerX},
+	}
+
+	if _, err := NewRulePlayer(boards.PlayerX).ReadMove(board); !errors.Is(err, ErrNoMoves) {
+		t.Errorf("expected ErrNoMoves, got %v", err)
+	}
+}
+
+func TestRulePlayer_NeverLoses(t *testing.T) {
+	for _, symbol := range []boards.Cell{boards.PlayerX, boards.PlayerO} {
+		player := NewRulePlayer(symbol)
+
+		exhaustNeverLoses(t, player, symbol, boards.NewBoard(), boards.PlayerX)
+		exhaustNeverLoses(t, player, symbol, boards.NewBoard(), boards.PlayerO)
+	}
+}
+
+// The rules only promise a draw or better from positions they steer into, so
+// the cross-check walks every game the rule player can reach rather than
+// every board.
+func TestRulePlayer_NeverGivesUpADrawThatMinimaxKeeps(t *testing.T) {
+	for _, symbol := range []boards.Cell{boards.PlayerX, boards.PlayerO} {
+		for _, first := range []boards.Cell{boards.PlayerX, boards.PlayerO} {
+			checkRuleMovesAgainstMinimax(t, NewRulePlayer(symbol), NewAIPlayer(symbol, symbol.Opponent()), symbol, boards.NewBoard(), first)
+		}
+	}
+}
+
+func checkRuleMovesAgainstMinimax(t *testing.T, rules *RulePlayer, ai *AIPlayer, symbol boards.Cell, board boards.Board, toMove boards.Cell) {
+	t.Helper()
+
+	if board.GetGameStatus() != boards.InProgress {
+		return
+	}
+
+	if toMove != symbol {
+		for _, move := range board.AvailableMoves() {
+			next := board
+			next.MakeMove(move, toMove)
+			checkRuleMovesAgainstMinimax(t, rules, ai, symbol, next, toMove.Opponent())
+		}
+		return
+	}
+
+	move, rule, _ := rules.ChooseMove(board)
+	_, bestScore := ai.BestMoves(board)
+	if score := ai.evaluateMove(boards.FromBoard(board), move); bestScore >= 0 && score < 0 {
+		t.Fatalf("board %v: %s plays %d by %s and loses where minimax holds", board, symbol, move, rule)
+	}
+
+	next := board
+	next.MakeMove(move, symbol)
+	checkRuleMovesAgainstMinimax(t, rules, ai, symbol, next, toMove.Opponent())
+}
@@ -0,0 +1,40 @@
+package players
+
+import (
+	"math"
+	"ttt/boards"
+	"ttt/tablebase"
+)
+
+type TablebasePlayer struct {
+	playerSymbol boards.Cell
+	table        *tablebase.Table
+}
+
+func NewTablebasePlayer(playerSymbol boards.Cell, table *tablebase.Table) *TablebasePlayer {
+	return &TablebasePlayer{
+		playerSymbol: playerSymbol,
+		table:        table,
+	}
+}
+
+func NewEmbeddedTablebasePlayer(playerSymbol boards.Cell) (*TablebasePlayer, error) {
+	table, err := tablebase.Embedded()
+	if err != nil {
+		return nil, err
+	}
+	return NewTablebasePlayer(playerSymbol, table), nil
+}
+
+func AISolver(board boards.Board, mover boards.Cell) (int8, []int) {
+	bestMoves, score := NewAIPlayer(mover, mover.Opponent()).BestMoves(board)
+	return int8(math.Round(score)), bestMoves
+}
+
+func (tablebasePlayer *TablebasePlayer) ReadMove(board boards.Board) (int, error) {
+	_, bestMoves, err := tablebasePlayer.table.Lookup(board, tablebasePlayer.playerSymbol)
+	if err != nil {
+		return 0, err
+	}
+	return bestMoves[0], nil
+}
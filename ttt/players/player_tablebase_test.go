@@ -0,0 +1,107 @@
+package players
+
+import (
+	"bytes"
+	"testing"
+	"ttt/boards"
+	"ttt/tablebase"
+)
+
+func TestTablebasePlayer_EmbeddedTableIsCurrent(t *testing.T) {
+	var fresh, embedded bytes.Buffer
+	table, err := tablebase.Embedded()
+	if err != nil {
+		t.Fatalf("embedded table should load: %v", err)
+	}
+
+	tablebase.Build(AISolver).WriteTo(&fresh)
+	table.WriteTo(&embedded)
+
+	if !bytes.Equal(fresh.Bytes(), embedded.Bytes()) {
+		t.Error("embedded tablebase is stale; run go generate ./tablebase")
+	}
+}
+
+func TestTablebasePlayer_TakesWinningMove(t *testing.T) {
+	player, _ := NewEmbeddedTablebasePlayer(boards.PlayerX)
+	board := boards.Board{
+		{boards.PlayerX, boards.PlayerX, boards.Empty},
+		{boards.PlayerO, boards.PlayerO, boards.Empty},
+		{boards.Empty, boards.Empty, boards.Empty},
+	}
+
+	move, err := player.ReadMove(board)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if move != 3 {
+		t.Errorf("should complete the top row at 3, got %d", move)
+	}
+}
+
+func TestTablebasePlayer_AgreesWithAIPlayer(t *testing.T) {
+	player, _ := NewEmbeddedTablebasePlayer(boards.PlayerO)
+	ai := NewAIPlayer(boards.PlayerO, boards.PlayerX)
+
+	for _, opening := range boards.NewBoard().AvailableMoves() {
+		board := boards.NewBoard()
+		board.MakeMove(opening, boards.PlayerX)
+
+		move, err := player.ReadMove(board)
+		if err != nil {
+			t.Fatalf("unexpected error after opening %d: %v", opening, err)
+		}
+
+		bestMoves, _ := ai.BestMoves(board)
+		if !containsMove(bestMoves, move) {
+			t.Errorf("after opening %d, tablebase move %d not among AI best moves %v", opening, move, bestMoves)
+		}
+	}
+}
+
+func containsMove(moves []int, move int) bool {
+	for _, candidate := range moves {
+		if candidate == move {
+			return true
+		}
+	}
+	return false
+}
+
+func exhaustNeverLoses(t *testing.T, player Player, symbol boards.Cell, board boards.Board, toMove boards.Cell) {
+	t.Helper()
+
+	if status := board.GetGameStatus(); status != boards.InProgress {
+		if winner := board.CheckWinner(); winner != boards.Empty && winner != symbol {
+			t.Fatalf("player %s lost on board %v", symbol, board)
+		}
+		return
+	}
+
+	if toMove == symbol {
+		move, err := player.ReadMove(board)
+		if err != nil {
+			t.Fatalf("unexpected error on board %v: %v", board, err)
+		}
+		board.MakeMove(move, toMove)
+		exhaustNeverLoses(t, player, symbol, board, toMove.Opponent())
+		return
+	}
+
+	for _, move := range board.AvailableMoves() {
+		boardCopy := board
+		boardCopy.MakeMove(move, toMove)
+		exhaustNeverLoses(t, player, symbol, boardCopy, toMove.Opponent())
+	}
+}
+
+func TestTablebasePlayer_NeverLoses(t *testing.T) {
+	for _, symbol := range []boards.Cell{boards.PlayerX, boards.PlayerO} {
+		player, _ := NewEmbeddedTablebasePlayer(symbol)
+
+		exhaustNeverLoses(t, player, symbol, boards.NewBoard(), boards.PlayerX)
+		exhaustNeverLoses(t, player, symbol, boards.NewBoard(), boards.PlayerO)
+	}
+}
@@ -0,0 +1,96 @@
+package players
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+	"ttt/boards"
+)
+
+type blockingPlayer struct {
+	release chan int
+}
+
+func (player *blockingPlayer) ReadMove(board boards.Board) (int, error) {
+	return <-player.release, nil
+}
+
+type cancelAwarePlayer struct {
+	calls int
+}
+
+func (player *cancelAwarePlayer) ReadMove(board boards.Board) (int, error) {
+	return 1, nil
+}
+
+func (player *cancelAwarePlayer) ReadMoveContext(ctx context.Context, board boards.Board) (int, error) {
+	player.calls++
+	return 2, nil
+}
+
+func TestAdaptContext_AbandonsBlockedPlayer(t *testing.T) {
+	player := &blockingPlayer{release: make(chan int)}
+	defer close(player.release)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := AdaptContext(player).ReadMoveContext(ctx, boards.NewBoard())
+
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context.Canceled, got %v", err)
+	}
+}
+
+func TestAdaptContext_DoesNotWaitForAbandonedRead(t *testing.T) {
+	player := &blockingPlayer{release: make(chan int)}
+	adapter := AdaptContext(player)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	adapter.ReadMoveContext(ctx, boards.NewBoard())
+
+	next, cancelNext := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancelNext()
+	if _, err := adapter.ReadMoveContext(next, boards.NewBoard()); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("the next read should give up with its own ctx, got %v", err)
+	}
+
+	settled := make(chan struct{})
+	adapter.(Settler).AfterSettled(func() { close(settled) })
+	select {
+	case <-settled:
+		t.Fatal("AfterSettled should wait for the abandoned read")
+	default:
+	}
+
+	player.release <- 1
+	select {
+	case <-settled:
+	case <-time.After(time.Second):
+		t.Error("AfterSettled should run once the abandoned read returns")
+	}
+}
+
+func TestAdaptContext_PassesMoveThrough(t *testing.T) {
+	player := &blockingPlayer{release: make(chan int, 1)}
+	player.release <- 4
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	move, err := AdaptContext(player).ReadMoveContext(ctx, boards.NewBoard())
+
+	if err != nil || move != 4 {
+		t.Errorf("expected move 4, got %d (%v)", move, err)
+	}
+}
+
+func TestAdaptContext_PrefersPlayersOwnMethod(t *testing.T) {
+	player := &cancelAwarePlayer{}
+
+	move, _ := AdaptContext(player).ReadMoveContext(context.Background(), boards.NewBoard())
+
+	if move != 2 || player.calls != 1 {
+		t.Errorf("expected the player's ReadMoveContext to answer, got move %d after %d calls", move, player.calls)
+	}
+}
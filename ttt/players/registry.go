@@ -0,0 +1,236 @@
+package players
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"os"
+	"slices"
+	"strconv"
+	"ttt/boards"
+	tttio "ttt/io"
+	"ttt/learning"
+	"ttt/retrograde"
+)
+
+const (
+	HumanKind = "human"
+	AIKind    = "ai"
+)
+
+var (
+	ErrUnknownKind   = errors.New("unknown player kind")
+	ErrUnknownOption = errors.New("unknown player option")
+)
+
+// KindOption is a setting a player kind accepts, such as a file to load.
+// Front ends turn these into flags or form fields.
+type KindOption struct {
+	Name    string `json:"name"`
+	Usage   string `json:"usage"`
+	Default string `json:"default,omitempty"`
+}
+
+// Seat is what a constructor gets to build a player for one side. Options
+// holds the kind's own settings, with defaults filled in for missing ones.
+type Seat struct {
+	Symbol  boards.Cell
+	Reader  *bufio.Reader
+	Output  io.Writer
+	Random  *rand.Rand
+	Options map[string]string
+}
+
+// Kind describes a type of player that menus, flags and servers can offer
+// without knowing about it in advance.
+type Kind struct {
+	Name        string                          `json:"name"`
+	Title       string                          `json:"title"`
+	Description string                          `json:"description"`
+	Options     []KindOption                    `json:"options,omitempty"`
+	New         func(seat Seat) (Player, error) `json:"-"`
+}
+
+var registry []Kind
+
+// Register adds a kind to the end of the menu. It panics on a missing or
+// duplicate name, like other registries built from init functions.
+func Register(kind Kind) {
+	if kind.Name == "" || kind.New == nil {
+		panic("players: Register needs a name and a constructor")
+	}
+	if _, ok := LookupKind(kind.Name); ok {
+		panic("players: Register called twice for " + kind.Name)
+	}
+	registry = append(registry, kind)
+}
+
+// Kinds lists the registered kinds in menu order.
+func Kinds() []Kind {
+	return slices.Clone(registry)
+}
+
+func LookupKind(name string) (Kind, bool) {
+	for _, kind := range registry {
+		if kind.Name == name {
+			return kind, true
+		}
+	}
+	return Kind{}, false
+}
+
+func KindNames() []string {
+	names := make([]string, len(registry))
+	for index, kind := range registry {
+		names[index] = kind.Name
+	}
+	return names
+}
+
+// MenuItems is the registry as a player type menu.
+func MenuItems() []tttio.MenuItem {
+	items := make([]tttio.MenuItem, len(registry))
+	for index, kind := range registry {
+		items[index] = tttio.MenuItem{Title: kind.Title, Description: kind.Description}
+	}
+	return items
+}
+
+func (kind Kind) option(name string) (KindOption, bool) {
+	for _, option := range kind.Options {
+		if option.Name == name {
+			return option, true
+		}
+	}
+	return KindOption{}, false
+}
+
+// CreatePlayer builds a player of the named kind. seat.Options may only name
+// options the kind declares.
+func CreatePlayer(name string, seat Seat) (Player, error) {
+	kind, ok := LookupKind(name)
+	if !ok {
+		return nil, fmt.Errorf("%w %q", ErrUnknownKind, name)
+	}
+
+	options := make(map[string]string, len(kind.Options))
+	for _, option := range kind.Options {
+		options[option.Name] = option.Default
+	}
+	for option, value := range seat.Options {
+		if _, ok := kind.option(option); !ok {
+			return nil, fmt.Errorf("%s: %w %q", name, ErrUnknownOption, option)
+		}
+		options[option] = value
+	}
+	seat.Options = options
+
+	player, err := kind.New(seat)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+	return player, nil
+}
+
+func newHuman(seat Seat) (Player, error) {
+	return NewHumanPlayer(seat.Reader, seat.Output), nil
+}
+
+func newAI(seat Seat) (Player, error) {
+	swindle, err := strconv.ParseBool(seat.Options["swindle"])
+	if err != nil {
+		return nil, fmt.Errorf("swindle: %w", err)
+	}
+
+	options := []AIOption{WithRandom(seat.Random)}
+	if swindle {
+		options = append(options, WithSwindle())
+	}
+	return NewAIPlayer(seat.Symbol, seat.Symbol.Opponent(), options...), nil
+}
+
+func newRules(seat Seat) (Player, error) {
+	return NewRulePlayer(seat.Symbol), nil
+}
+
+func newTablebase(seat Seat) (Player, error) {
+	player, err := NewEmbeddedTablebasePlayer(seat.Symbol)
+	if err != nil {
+		return nil, err
+	}
+	return player, nil
+}
+
+func newMenace(seat Seat) (Player, error) {
+	path := seat.Options["table"]
+	if path == "" {
+		return NewLearningPlayer(seat.Symbol, learning.NewTable(), seat.Random), nil
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	table, err := learning.Read(bufio.NewReader(file))
+	if err != nil {
+		return nil, err
+	}
+	return NewLearningPlayer(seat.Symbol, table, seat.Random), nil
+}
+
+func loadRetrogradeTable(path string) (*retrograde.Table, error) {
+	if path == "" {
+		return retrograde.Build(boards.BoardSize, 0, nil)
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return retrograde.Read(bufio.NewReader(file))
+}
+
+func newRetrograde(seat Seat) (Player, error) {
+	table, err := loadRetrogradeTable(seat.Options["table"])
+	if err != nil {
+		return nil, err
+	}
+
+	if table.Size != boards.BoardSize {
+		return nil, fmt.Errorf("%w: %s is %dx%d", ErrTableSize, seat.Options["table"], table.Size, table.Size)
+	}
+	return NewRetrogradePlayer(seat.Symbol, table), nil
+}
+
+func init() {
+	Register(Kind{Name: HumanKind, Title: "Human", Description: "moves typed at the keyboard", New: newHuman})
+	Register(Kind{
+		Name:        AIKind,
+		Title:       "AI",
+		Description: "perfect minimax search",
+		Options:     []KindOption{{Name: "swindle", Usage: "prefer moves that set traps", Default: "false"}},
+		New:         newAI,
+	})
+	Register(Kind{Name: "rules", Title: "Rules", Description: "classic strategy rules, no search", New: newRules})
+	Register(Kind{Name: "tablebase", Title: "Tablebase", Description: "perfect play from the built-in tablebase", New: newTablebase})
+	Register(Kind{
+		Name:        "menace",
+		Title:       "MENACE",
+		Description: "matchbox learner",
+		Options:     []KindOption{{Name: "table", Usage: "bead table trained with the train command (empty starts fresh)"}},
+		New:         newMenace,
+	})
+	Register(Kind{
+		Name:        "retrograde",
+		Title:       "Retrograde",
+		Description: "endgame table with search before it",
+		Options:     []KindOption{{Name: "table", Usage: "3x3 table written by cmd/retrograde (empty solves the whole game at start)"}},
+		New:         newRetrograde,
+	})
+}
@@ -0,0 +1,79 @@
+package players
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"ttt/boards"
+	"ttt/retrograde"
+)
+
+func TestKinds_KeepsHumanAndAIFirst(t *testing.T) {
+	names := KindNames()
+
+	if len(names) < 2 || names[0] != HumanKind || names[1] != AIKind {
+		t.Errorf("menu should start with human then ai, got %v", names)
+	}
+
+	if len(MenuItems()) != len(names) {
+		t.Error("menu should have one item per kind")
+	}
+}
+
+func TestCreatePlayer_BuildsEachBuiltInKind(t *testing.T) {
+	for _, name := range []string{HumanKind, AIKind, "rules", "tablebase", "menace", "retrograde"} {
+		player, err := CreatePlayer(name, Seat{Symbol: boards.PlayerO})
+
+		if err != nil || player == nil {
+			t.Errorf("%s: should build a player, got %v", name, err)
+		}
+	}
+}
+
+func TestCreatePlayer_AppliesOptions(t *testing.T) {
+	player, err := CreatePlayer(AIKind, Seat{Symbol: boards.PlayerX, Options: map[string]string{"swindle": "true"}})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if ai, ok := player.(*AIPlayer); !ok || !ai.swindle {
+		t.Error("swindle option should build a swindling AI")
+	}
+}
+
+func TestCreatePlayer_RejectsUnknownNames(t *testing.T) {
+	if _, err := CreatePlayer("oracle", Seat{Symbol: boards.PlayerX}); !errors.Is(err, ErrUnknownKind) {
+		t.Errorf("should reject an unregistered kind, got %v", err)
+	}
+
+	_, err := CreatePlayer("rules", Seat{Symbol: boards.PlayerX, Options: map[string]string{"depth": "3"}})
+	if !errors.Is(err, ErrUnknownOption) {
+		t.Errorf("should reject an option the kind does not declare, got %v", err)
+	}
+}
+
+func TestRegister_PanicsOnDuplicateName(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("registering a name twice should panic")
+		}
+	}()
+
+	Register(Kind{Name: AIKind, New: newAI})
+}
+
+func TestCreatePlayer_RetrogradeRejectsLargerTables(t *testing.T) {
+	table, _ := retrograde.Build(4, 14, nil)
+	path := filepath.Join(t.TempDir(), "ttt4.rtb")
+	file, _ := os.Create(path)
+	table.WriteTo(file)
+	file.Close()
+
+	_, err := CreatePlayer("retrograde", Seat{Symbol: boards.PlayerX, Options: map[string]string{"table": path}})
+
+	if !errors.Is(err, ErrTableSize) {
+		t.Errorf("a 4x4 table should be refused, got %v", err)
+	}
+}
@@ -0,0 +1,76 @@
+package protocol
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"ttt/boards"
+	"ttt/players"
+)
+
+// NewPlayer builds the player that answers go for the side to move.
+type NewPlayer func(symbol boards.Cell) players.Player
+
+// Serve answers protocol commands from reader on writer until quit or the
+// end of input. Malformed commands get an info line and are otherwise
+// ignored, so a typo does not end the session.
+func Serve(reader io.Reader, writer io.Writer, name string, newPlayer NewPlayer) error {
+	lines := bufio.NewScanner(reader)
+	position := Position{First: boards.PlayerX}
+
+	for lines.Scan() {
+		fields := strings.Fields(lines.Text())
+		if len(fields) == 0 {
+			continue
+		}
+
+		switch fields[0] {
+		case CmdHello:
+			fmt.Fprintf(writer, "%s name %s\n%s\n", RespID, name, RespHelloOK)
+		case CmdIsReady:
+			fmt.Fprintln(writer, RespReadyOK)
+		case CmdPosition:
+			parsed, err := ParsePosition(fields[1:])
+			if err != nil {
+				fmt.Fprintf(writer, "%s error %v\n", RespInfo, err)
+				continue
+			}
+			position = parsed
+		case CmdGo:
+			fmt.Fprintln(writer, search(position, fields[1:], newPlayer))
+		case CmdQuit:
+			return nil
+		default:
+			fmt.Fprintf(writer, "%s error unknown command %q\n", RespInfo, fields[0])
+		}
+	}
+
+	return lines.Err()
+}
+
+func search(position Position, fields []string, newPlayer NewPlayer) string {
+	limit, err := ParseGo(fields)
+	if err != nil {
+		return fmt.Sprintf("%s error %v\n%s", RespInfo, err, bestMoveLine(0, false))
+	}
+
+	board, mover, err := position.Replay()
+	if err != nil || board.GetGameStatus() != boards.InProgress {
+		return bestMoveLine(0, false)
+	}
+
+	ctx := context.Background()
+	if limit > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, limit)
+		defer cancel()
+	}
+
+	move, err := players.AdaptContext(newPlayer(mover)).ReadMoveContext(ctx, board)
+	if err != nil || !board.IsPositionValid(move) {
+		return bestMoveLine(0, false)
+	}
+	return bestMoveLine(move, true)
+}
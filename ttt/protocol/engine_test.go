@@ -0,0 +1,153 @@
+package protocol
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+	"ttt/boards"
+	"ttt/players"
+)
+
+func newAI(symbol boards.Cell) players.Player {
+	return players.NewAIPlayer(symbol, symbol.Opponent())
+}
+
+func TestServe_Transcript(t *testing.T) {
+	input := strings.Join([]string{
+		"ttt",
+		"isready",
+		"position startpos moves 1 4 2 5",
+		"go movetime 500",
+		"position startpos first o moves 5 1 9",
+		"go",
+		"position startpos moves 1 4 2 5 3",
+		"go",
+		"bogus",
+		"quit",
+		"isready",
+	}, "\n")
+	var output bytes.Buffer
+
+	if err := Serve(strings.NewReader(input), &output, "test", newAI); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := strings.Join([]string{
+		"id name test",
+		"tttok",
+		"readyok",
+		"bestmove 3",
+		"bestmove 3",
+		"bestmove none",
+		`info error unknown command "bogus"`,
+		"",
+	}, "\n")
+	if output.String() != expected {
+		t.Errorf("expected:\n%s\ngot:\n%s", expected, output.String())
+	}
+}
+
+func connect(t *testing.T, symbol boards.Cell, newPlayer NewPlayer) *EnginePlayer {
+	t.Helper()
+
+	toEngine, engineInput := io.Pipe()
+	engineOutput, fromEngine := io.Pipe()
+	go func() {
+		Serve(toEngine, fromEngine, "piped", newPlayer)
+		fromEngine.Close()
+	}()
+
+	engine, err := NewEnginePlayer(engineOutput, engineInput, symbol, 0)
+	if err != nil {
+		t.Fatalf("handshake failed: %v", err)
+	}
+	t.Cleanup(func() { engine.Close() })
+	return engine
+}
+
+func TestEnginePlayer_PlaysThroughProtocol(t *testing.T) {
+	engine := connect(t, boards.PlayerO, newAI)
+	board := boards.Board{
+		{boards.PlayerX, boards.PlayerX, boards.Empty},
+		{boards.PlayerO, boards.PlayerO, boards.Empty},
+		{boards.PlayerX, boards.Empty, boards.Empty},
+	}
+
+	move, err := engine.ReadMove(board)
+
+	if err != nil || move != 6 {
+		t.Errorf("expected the engine to win at 6, got %d (%v)", move, err)
+	}
+	if engine.Name != "piped" {
+		t.Errorf("expected the engine name from the handshake, got %q", engine.Name)
+	}
+}
+
+type slowPlayer struct {
+	delay time.Duration
+}
+
+func (player slowPlayer) ReadMove(board boards.Board) (int, error) {
+	time.Sleep(player.delay)
+	return board.AvailableMoves()[0], nil
+}
+
+func TestEnginePlayer_SkipsAbandonedBestMove(t *testing.T) {
+	engine := connect(t, boards.PlayerX, func(symbol boards.Cell) players.Player {
+		return slowPlayer{delay: 50 * time.Millisecond}
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := engine.ReadMoveContext(ctx, boards.NewBoard()); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+
+	board := boards.NewBoard()
+	board.MakeMove(1, boards.PlayerX)
+	board.MakeMove(2, boards.PlayerO)
+	move, err := engine.ReadMove(board)
+
+	if err != nil || move != 3 {
+		t.Errorf("expected the answer to the second position (3), got %d (%v)", move, err)
+	}
+}
+
+func TestEnginePlayer_CloseDrainsUnreadLines(t *testing.T) {
+	engineOutput, fromEngine := io.Pipe()
+	go fromEngine.Write([]byte(RespHelloOK + "\n"))
+	engine, err := NewEnginePlayer(engineOutput, io.Discard, boards.PlayerX, 0)
+	if err != nil {
+		t.Fatalf("handshake failed: %v", err)
+	}
+
+	written := make(chan struct{})
+	go func() {
+		for range 4 * lineBuffer {
+			fromEngine.Write([]byte(RespBestMove + " 1\n"))
+		}
+		fromEngine.Close()
+		close(written)
+	}()
+
+	time.Sleep(20 * time.Millisecond)
+	engine.Close()
+
+	select {
+	case <-written:
+	case <-time.After(time.Second):
+		t.Fatal("lines written after Close should be drained, not left blocking the engine")
+	}
+}
+
+func TestEngineKind_NeedsCommand(t *testing.T) {
+	_, err := players.CreatePlayer(EngineKind, players.Seat{Symbol: boards.PlayerX})
+
+	if !errors.Is(err, ErrNoCommand) {
+		t.Errorf("engine kind without cmd should fail, got %v", err)
+	}
+}
@@ -0,0 +1,194 @@
+package protocol
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+	"ttt/boards"
+)
+
+// engineMargin is kept back from a deadline for the reply to travel.
+const (
+	handshakeTimeout = 5 * time.Second
+	engineMargin     = 10 * time.Millisecond
+	lineBuffer       = 16
+)
+
+var ErrEngineExited = errors.New("protocol: engine exited")
+
+// EnginePlayer is a players.Player backed by an engine speaking the
+// protocol, usually an external program started with StartEngine.
+type EnginePlayer struct {
+	Name string
+
+	symbol   boards.Cell
+	moveTime time.Duration
+	input    io.Writer
+	lines    chan []string
+	done     chan struct{} // closed by Close; later lines are read and dropped
+	stop     sync.Once
+	stale    int // searches abandoned on cancel whose bestmove is still due
+	closer   func() error
+}
+
+// NewEnginePlayer greets the engine on the other end of input and output.
+// moveTime is sent with every go command; zero sends no limit unless the
+// move's context has a deadline.
+func NewEnginePlayer(output io.Reader, input io.Writer, symbol boards.Cell, moveTime time.Duration) (*EnginePlayer, error) {
+	engine := &EnginePlayer{
+		symbol:   symbol,
+		moveTime: moveTime,
+		input:    input,
+		lines:    make(chan []string, lineBuffer),
+		done:     make(chan struct{}),
+		closer:   func() error { return nil },
+	}
+	go engine.readLines(output)
+
+	if err := engine.handshake(); err != nil {
+		return nil, err
+	}
+	return engine, nil
+}
+
+// StartEngine launches path with args and greets it.
+func StartEngine(symbol boards.Cell, moveTime time.Duration, path string, args ...string) (*EnginePlayer, error) {
+	command := exec.Command(path, args...)
+	input, err := command.StdinPipe()
+	if err != nil {
+		return nil, err
+	}
+	output, err := command.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := command.Start(); err != nil {
+		return nil, err
+	}
+
+	engine, err := NewEnginePlayer(output, input, symbol, moveTime)
+	if err != nil {
+		command.Process.Kill()
+		command.Wait()
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	engine.closer = func() error {
+		input.Close()
+		return command.Wait()
+	}
+	return engine, nil
+}
+
+// readLines passes the engine's lines on until its output ends. Once the
+// player is closed nobody reads them, so they are drained instead, which
+// also keeps the engine from blocking on a full pipe as it exits.
+func (engine *EnginePlayer) readLines(output io.Reader) {
+	scanner := bufio.NewScanner(output)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 || fields[0] == RespInfo {
+			continue
+		}
+
+		select {
+		case engine.lines <- fields:
+		case <-engine.done:
+		}
+	}
+	close(engine.lines)
+}
+
+func (engine *EnginePlayer) send(command string) error {
+	_, err := fmt.Fprintln(engine.input, command)
+	return err
+}
+
+// await returns the next line starting with want, skipping anything else.
+func (engine *EnginePlayer) await(ctx context.Context, want string) ([]string, error) {
+	for {
+		select {
+		case fields, ok := <-engine.lines:
+			if !ok {
+				return nil, ErrEngineExited
+			}
+			if fields[0] == want {
+				return fields[1:], nil
+			}
+			if fields[0] == RespID && len(fields) > 2 && fields[1] == "name" {
+				engine.Name = strings.Join(fields[2:], " ")
+			}
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+func (engine *EnginePlayer) handshake() error {
+	if err := engine.send(CmdHello); err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), handshakeTimeout)
+	defer cancel()
+	_, err := engine.await(ctx, RespHelloOK)
+	return err
+}
+
+func (engine *EnginePlayer) ReadMove(board boards.Board) (int, error) {
+	return engine.ReadMoveContext(context.Background(), board)
+}
+
+// ReadMoveContext asks for a move within the smaller of the move time and
+// ctx's deadline. A search abandoned on cancel has its late bestmove
+// skipped by the next request.
+func (engine *EnginePlayer) ReadMoveContext(ctx context.Context, board boards.Board) (int, error) {
+	for ; engine.stale > 0; engine.stale-- {
+		if _, err := engine.await(ctx, RespBestMove); err != nil {
+			return 0, err
+		}
+	}
+
+	limit := engine.moveTime
+	if deadline, ok := ctx.Deadline(); ok {
+		left := max(time.Until(deadline)-engineMargin, time.Millisecond)
+		if limit == 0 || left < limit {
+			limit = left
+		}
+	}
+
+	if err := engine.send(PositionFor(board, engine.symbol).String()); err != nil {
+		return 0, err
+	}
+	if err := engine.send(goCommand(limit)); err != nil {
+		return 0, err
+	}
+
+	fields, err := engine.await(ctx, RespBestMove)
+	if err != nil {
+		if !errors.Is(err, ErrEngineExited) {
+			engine.stale++
+		}
+		return 0, err
+	}
+	return ParseBestMove(fields)
+}
+
+// Close asks the engine to quit and waits for it to exit. Only the first
+// call does anything.
+func (engine *EnginePlayer) Close() error {
+	var err error
+	engine.stop.Do(func() {
+		close(engine.done)
+		engine.send(CmdQuit)
+		err = engine.closer()
+	})
+	return err
+}
@@ -0,0 +1,201 @@
+package protocol
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"ttt/boards"
+)
+
+// The engine protocol is line based over the engine's stdin and stdout,
+// one command per line, fields separated by spaces:
+//
+//	ttt                                   → id name <name>, then tttok
+//	isready                               → readyok
+//	position startpos [first x|o] [moves <p> ...]
+//	go [movetime <ms>]                    → bestmove <p> (or bestmove none)
+//	quit
+//
+// Positions are numbered 1-9 from the top left. X moves first unless
+// "first o" is given, and the moves alternate from the first player. The
+// engine answers go for the side to move; lines starting with "info" are
+// free-form and ignored by the player.
+const (
+	CmdHello    = "ttt"
+	CmdIsReady  = "isready"
+	CmdPosition = "position"
+	CmdGo       = "go"
+	CmdQuit     = "quit"
+
+	RespID       = "id"
+	RespHelloOK  = "tttok"
+	RespReadyOK  = "readyok"
+	RespBestMove = "bestmove"
+	RespInfo     = "info"
+
+	startPos  = "startpos"
+	firstWord = "first"
+	movesWord = "moves"
+	moveTime  = "movetime"
+	noMove    = "none"
+)
+
+var (
+	ErrBadCommand  = errors.New("protocol: malformed command")
+	ErrIllegalMove = errors.New("protocol: illegal move in position")
+	ErrNoBestMove  = errors.New("protocol: engine has no move")
+)
+
+type Position struct {
+	First boards.Cell
+	Moves []int
+}
+
+func (position Position) String() string {
+	command := []string{CmdPosition, startPos}
+	if position.First == boards.PlayerO {
+		command = append(command, firstWord, "o")
+	}
+
+	if len(position.Moves) > 0 {
+		command = append(command, movesWord)
+		for _, move := range position.Moves {
+			command = append(command, strconv.Itoa(move))
+		}
+	}
+	return strings.Join(command, " ")
+}
+
+// ParsePosition reads the fields after "position".
+func ParsePosition(fields []string) (Position, error) {
+	if len(fields) == 0 || fields[0] != startPos {
+		return Position{}, fmt.Errorf("%w: position must start with %s", ErrBadCommand, startPos)
+	}
+	fields = fields[1:]
+
+	position := Position{First: boards.PlayerX}
+	if len(fields) >= 2 && fields[0] == firstWord {
+		switch strings.ToLower(fields[1]) {
+		case "x":
+		case "o":
+			position.First = boards.PlayerO
+		default:
+			return Position{}, fmt.Errorf("%w: first must be x or o", ErrBadCommand)
+		}
+		fields = fields[2:]
+	}
+
+	if len(fields) == 0 {
+		return position, nil
+	}
+
+	if fields[0] != movesWord {
+		return Position{}, fmt.Errorf("%w: unexpected %q", ErrBadCommand, fields[0])
+	}
+
+	for _, field := range fields[1:] {
+		move, err := strconv.Atoi(field)
+		if err != nil {
+			return Position{}, fmt.Errorf("%w: move %q is not a number", ErrBadCommand, field)
+		}
+		position.Moves = append(position.Moves, move)
+	}
+	return position, nil
+}
+
+// Replay plays the moves and returns the board and the side to move.
+func (position Position) Replay() (boards.Board, boards.Cell, error) {
+	board := boards.NewBoard()
+	mover := position.First
+
+	for _, move := range position.Moves {
+		if board.GetGameStatus() != boards.InProgress {
+			return board, mover, fmt.Errorf("%w: %d after the game ended", ErrIllegalMove, move)
+		}
+		if err := board.MakeMove(move, mover); err != nil {
+			return board, mover, fmt.Errorf("%w: %d: %w", ErrIllegalMove, move, err)
+		}
+		mover = mover.Opponent()
+	}
+	return board, mover, nil
+}
+
+// PositionFor rebuilds a move order that reaches board with mover to play.
+// Any order works: the game is still in progress, so no earlier position
+// had a finished line either.
+func PositionFor(board boards.Board, mover boards.Cell) Position {
+	var own, other []int
+	for position := boards.MinPosition; position <= boards.MaxPosition; position++ {
+		switch board.At(position) {
+		case mover:
+			own = append(own, position)
+		case mover.Opponent():
+			other = append(other, position)
+		}
+	}
+
+	first, second := own, other
+	result := Position{First: mover}
+	if len(other) > len(own) {
+		first, second = other, own
+		result.First = mover.Opponent()
+	}
+
+	for index := range first {
+		result.Moves = append(result.Moves, first[index])
+		if index < len(second) {
+			result.Moves = append(result.Moves, second[index])
+		}
+	}
+	return result
+}
+
+func goCommand(limit time.Duration) string {
+	if limit <= 0 {
+		return CmdGo
+	}
+	return fmt.Sprintf("%s %s %d", CmdGo, moveTime, limit.Milliseconds())
+}
+
+// ParseGo reads the fields after "go"; zero means no time limit.
+func ParseGo(fields []string) (time.Duration, error) {
+	if len(fields) == 0 {
+		return 0, nil
+	}
+
+	if len(fields) != 2 || fields[0] != moveTime {
+		return 0, fmt.Errorf("%w: go takes movetime <ms>", ErrBadCommand)
+	}
+
+	milliseconds, err := strconv.Atoi(fields[1])
+	if err != nil || milliseconds < 0 {
+		return 0, fmt.Errorf("%w: movetime %q", ErrBadCommand, fields[1])
+	}
+	return time.Duration(milliseconds) * time.Millisecond, nil
+}
+
+func bestMoveLine(move int, ok bool) string {
+	if !ok {
+		return RespBestMove + " " + noMove
+	}
+	return fmt.Sprintf("%s %d", RespBestMove, move)
+}
+
+// ParseBestMove reads the fields after "bestmove".
+func ParseBestMove(fields []string) (int, error) {
+	if len(fields) != 1 {
+		return 0, fmt.Errorf("%w: bestmove takes one move", ErrBadCommand)
+	}
+
+	if fields[0] == noMove {
+		return 0, ErrNoBestMove
+	}
+
+	move, err := strconv.Atoi(fields[0])
+	if err != nil {
+		return 0, fmt.Errorf("%w: bestmove %q", ErrBadCommand, fields[0])
+	}
+	return move, nil
+}
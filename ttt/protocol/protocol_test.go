@@ -0,0 +1,112 @@
+package protocol
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+	"ttt/boards"
+)
+
+func TestPosition_RoundTrip(t *testing.T) {
+	tests := []Position{
+		{First: boards.PlayerX},
+		{First: boards.PlayerX, Moves: []int{5, 1, 9}},
+		{First: boards.PlayerO, Moves: []int{3}},
+	}
+
+	for _, position := range tests {
+		fields := strings.Fields(position.String())
+		if fields[0] != CmdPosition {
+			t.Fatalf("%q should start with %s", position, CmdPosition)
+		}
+
+		parsed, err := ParsePosition(fields[1:])
+		if err != nil {
+			t.Fatalf("%q: unexpected error %v", position, err)
+		}
+		if parsed.First != position.First || !slices.Equal(parsed.Moves, position.Moves) {
+			t.Errorf("%q parsed as %+v", position, parsed)
+		}
+	}
+}
+
+func TestParsePosition_RejectsMalformed(t *testing.T) {
+	for _, command := range []string{"", "fen x", "startpos first z", "startpos moves a", "startpos 5"} {
+		if _, err := ParsePosition(strings.Fields(command)); !errors.Is(err, ErrBadCommand) {
+			t.Errorf("%q: expected ErrBadCommand, got %v", command, err)
+		}
+	}
+}
+
+func TestPosition_ReplayRejectsIllegalMoves(t *testing.T) {
+	tests := []Position{
+		{First: boards.PlayerX, Moves: []int{5, 5}},
+		{First: boards.PlayerX, Moves: []int{10}},
+		{First: boards.PlayerX, Moves: []int{1, 4, 2, 5, 3, 6}},
+	}
+
+	for _, position := range tests {
+		if _, _, err := position.Replay(); !errors.Is(err, ErrIllegalMove) {
+			t.Errorf("%q: expected ErrIllegalMove, got %v", position, err)
+		}
+	}
+}
+
+func TestPositionFor_ReachesBoardWithMoverToPlay(t *testing.T) {
+	for index := boards.Index(0); index < boards.IndexCount; index++ {
+		board, _ := boards.FromIndex(index)
+		if board.GetGameStatus() != boards.InProgress {
+			continue
+		}
+
+		for _, mover := range []boards.Cell{boards.PlayerX, boards.PlayerO} {
+			xCount, oCount := 0, 0
+			for position := boards.MinPosition; position <= boards.MaxPosition; position++ {
+				switch board.At(position) {
+				case boards.PlayerX:
+					xCount++
+				case boards.PlayerO:
+					oCount++
+				}
+			}
+			own, other := xCount, oCount
+			if mover == boards.PlayerO {
+				own, other = oCount, xCount
+			}
+			if own != other && own != other-1 {
+				continue
+			}
+
+			replayed, toMove, err := PositionFor(board, mover).Replay()
+			if err != nil || replayed != board || toMove != mover {
+				t.Fatalf("board %d with %s to move replayed to %v, %s (%v)", index, mover, replayed, toMove, err)
+			}
+		}
+	}
+}
+
+func TestParseGo(t *testing.T) {
+	if limit, err := ParseGo(nil); err != nil || limit != 0 {
+		t.Errorf("bare go should have no limit, got %v (%v)", limit, err)
+	}
+
+	if limit, err := ParseGo([]string{"movetime", "250"}); err != nil || limit != 250*time.Millisecond {
+		t.Errorf("expected 250ms, got %v (%v)", limit, err)
+	}
+
+	if _, err := ParseGo([]string{"movetime", "-1"}); !errors.Is(err, ErrBadCommand) {
+		t.Errorf("expected ErrBadCommand, got %v", err)
+	}
+}
+
+func TestParseBestMove(t *testing.T) {
+	if move, err := ParseBestMove([]string{"7"}); err != nil || move != 7 {
+		t.Errorf("expected 7, got %d (%v)", move, err)
+	}
+
+	if _, err := ParseBestMove([]string{"none"}); !errors.Is(err, ErrNoBestMove) {
+		t.Errorf("expected ErrNoBestMove, got %v", err)
+	}
+}
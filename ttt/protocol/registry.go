@@ -0,0 +1,54 @@
+package protocol
+
+import (
+	"errors"
+	"strings"
+	"time"
+	"ttt/boards"
+	"ttt/players"
+)
+
+const EngineKind = "engine"
+
+var ErrNoCommand = errors.New("no engine command; set the cmd option")
+
+// gameEngine is an engine started for one game; it shuts the process down
+// when the game ends.
+type gameEngine struct {
+	*EnginePlayer
+}
+
+func (engine gameEngine) GameEnded(status boards.GameStatus, board boards.Board) {
+	engine.Close()
+}
+
+func newEngine(seat players.Seat) (players.Player, error) {
+	command := strings.Fields(seat.Options["cmd"])
+	if len(command) == 0 {
+		return nil, ErrNoCommand
+	}
+
+	moveTime, err := time.ParseDuration(seat.Options["move-time"])
+	if err != nil {
+		return nil, err
+	}
+
+	engine, err := StartEngine(seat.Symbol, moveTime, command[0], command[1:]...)
+	if err != nil {
+		return nil, err
+	}
+	return gameEngine{engine}, nil
+}
+
+func init() {
+	players.Register(players.Kind{
+		Name:        EngineKind,
+		Title:       "Engine",
+		Description: "external program speaking the engine protocol",
+		Options: []players.KindOption{
+			{Name: "cmd", Usage: "engine command line, e.g. \"./ttt engine\""},
+			{Name: "move-time", Usage: "time limit for each engine move", Default: "1s"},
+		},
+		New: newEngine,
+	})
+}
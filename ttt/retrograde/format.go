@@ -0,0 +1,102 @@
+package retrograde
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// File layout (all integers big-endian):
+//
+//	magic      [4]byte  "TTTR"
+//	version    uint8    1
+//	size       uint8    board side length
+//	minPieces  uint8    fewest stones of any stored position
+//	count      uint64   number of records
+//	records    count × { key uint64, value uint8 }
+//
+// Records are sorted by key, the base-3 position index described on
+// Geometry.Key. The value byte holds the outcome for the side to move in its
+// top two bits (1 win, 2 loss, 3 draw) and the plies to the end of the game
+// under best play in its low six bits. X is assumed to have moved first.
+const (
+	Magic       = "TTTR"
+	Version     = 1
+	headerBytes = len(Magic) + 3 + 8
+	recordBytes = 9
+)
+
+var (
+	ErrBadMagic   = errors.New("retrograde: bad magic")
+	ErrBadVersion = errors.New("retrograde: unsupported version")
+	ErrUnsorted   = errors.New("retrograde: records are not sorted")
+)
+
+func (table *Table) WriteTo(writer io.Writer) (int64, error) {
+	buffered := bufio.NewWriter(writer)
+
+	header := []byte(Magic)
+	header = append(header, Version, byte(table.Size), byte(table.MinPieces))
+	header = binary.BigEndian.AppendUint64(header, uint64(len(table.records)))
+	written, err := buffered.Write(header)
+	total := int64(written)
+	if err != nil {
+		return total, err
+	}
+
+	record := make([]byte, recordBytes)
+	for _, stored := range table.records {
+		binary.BigEndian.PutUint64(record, stored.Key)
+		record[8] = byte(stored.Value)
+		written, err = buffered.Write(record)
+		total += int64(written)
+		if err != nil {
+			return total, err
+		}
+	}
+
+	return total, buffered.Flush()
+}
+
+func Read(reader io.Reader) (*Table, error) {
+	buffered := bufio.NewReader(reader)
+
+	header := make([]byte, headerBytes)
+	if _, err := io.ReadFull(buffered, header); err != nil {
+		return nil, fmt.Errorf("retrograde: reading header: %w", err)
+	}
+
+	if string(header[:len(Magic)]) != Magic {
+		return nil, ErrBadMagic
+	}
+
+	if header[len(Magic)] != Version {
+		return nil, ErrBadVersion
+	}
+
+	table := &Table{
+		Size:      int(header[len(Magic)+1]),
+		MinPieces: int(header[len(Magic)+2]),
+	}
+	if _, err := NewGeometry(table.Size); err != nil {
+		return nil, err
+	}
+
+	count := binary.BigEndian.Uint64(header[len(Magic)+3:])
+	record := make([]byte, recordBytes)
+	for range count {
+		if _, err := io.ReadFull(buffered, record); err != nil {
+			return nil, fmt.Errorf("retrograde: reading records: %w", err)
+		}
+
+		stored := Record{Key: binary.BigEndian.Uint64(record), Value: Value(record[8])}
+		if len(table.records) > 0 && table.records[len(table.records)-1].Key >= stored.Key {
+			return nil, ErrUnsorted
+		}
+		table.records = append(table.records, stored)
+	}
+
+	return table, nil
+}
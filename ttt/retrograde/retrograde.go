@@ -0,0 +1,415 @@
+package retrograde
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"math/bits"
+	"slices"
+	"sort"
+)
+
+// MaxSize is the largest board Build accepts. 5x5 would fit the key but
+// has far too many positions to solve in memory.
+const (
+	MinSize  = 3
+	MaxSize  = 4
+	cellBase = 3
+)
+
+type Outcome uint8
+
+const (
+	Unknown Outcome = iota
+	Win
+	Loss
+	Draw
+)
+
+// Value packs an outcome for the side to move (top two bits) with the number
+// of plies to the end of the game under best play (low six bits).
+type Value uint8
+
+const distanceMask = 0x3f
+
+func NewValue(outcome Outcome, distance int) Value {
+	return Value(outcome)<<6 | Value(distance&distanceMask)
+}
+
+func (value Value) Outcome() Outcome {
+	return Outcome(value >> 6)
+}
+
+func (value Value) Distance() int {
+	return int(value & distanceMask)
+}
+
+func (outcome Outcome) String() string {
+	switch outcome {
+	case Win:
+		return "win"
+	case Loss:
+		return "loss"
+	case Draw:
+		return "draw"
+	default:
+		return "unknown"
+	}
+}
+
+var (
+	ErrBadSize      = fmt.Errorf("retrograde: size must be between %d and %d", MinSize, MaxSize)
+	ErrBadMinPieces = errors.New("retrograde: min pieces must be between 0 and size*size")
+)
+
+type Record struct {
+	Key   uint64
+	Value Value
+}
+
+type Table struct {
+	Size      int
+	MinPieces int
+	records   []Record
+}
+
+type Geometry struct {
+	size   int
+	cells  int
+	lines  []uint32
+	powers []uint64
+}
+
+func NewGeometry(size int) (Geometry, error) {
+	if size < MinSize || size > MaxSize {
+		return Geometry{}, ErrBadSize
+	}
+
+	geometry := Geometry{size: size, cells: size * size}
+	var diagonal, antiDiagonal uint32
+	for row := range size {
+		var rowLine, colLine uint32
+		for col := range size {
+			rowLine |= 1 << (row*size + col)
+			colLine |= 1 << (col*size + row)
+		}
+		geometry.lines = append(geometry.lines, rowLine, colLine)
+		diagonal |= 1 << (row*size + row)
+		antiDiagonal |= 1 << (row*size + size - 1 - row)
+	}
+	geometry.lines = append(geometry.lines, diagonal, antiDiagonal)
+
+	power := uint64(1)
+	for range geometry.cells {
+		geometry.powers = append(geometry.powers, power)
+		power *= cellBase
+	}
+
+	return geometry, nil
+}
+
+func (geometry Geometry) hasLine(mask uint32) bool {
+	for _, line := range geometry.lines {
+		if mask&line == line {
+			return true
+		}
+	}
+	return false
+}
+
+// Key is the base-3 index of a position: cell i (row-major from the top
+// left) contributes digit 0 for empty, 1 for X and 2 for O times 3^i. For
+// 3x3 boards it equals boards.Index.
+func (geometry Geometry) Key(x uint32, o uint32) uint64 {
+	var key uint64
+	for cell := range geometry.cells {
+		switch {
+		case x&(1<<cell) != 0:
+			key += geometry.powers[cell]
+		case o&(1<<cell) != 0:
+			key += 2 * geometry.powers[cell]
+		}
+	}
+	return key
+}
+
+func (geometry Geometry) Decode(key uint64) (uint32, uint32) {
+	var x, o uint32
+	for cell := range geometry.cells {
+		switch key % cellBase {
+		case 1:
+			x |= 1 << cell
+		case 2:
+			o |= 1 << cell
+		}
+		key /= cellBase
+	}
+	return x, o
+}
+
+// enumerate visits every placement of xCount X and oCount O pieces in
+// increasing key order by fixing the most significant cell first.
+func (geometry Geometry) enumerate(xCount int, oCount int, visit func(x uint32, o uint32, key uint64)) {
+	var place func(cell int, x uint32, o uint32, key uint64, xLeft int, oLeft int)
+	place = func(cell int, x uint32, o uint32, key uint64, xLeft int, oLeft int) {
+		if xLeft+oLeft > cell+1 {
+			return
+		}
+		if cell < 0 {
+			visit(x, o, key)
+			return
+		}
+		place(cell-1, x, o, key, xLeft, oLeft)
+		if xLeft > 0 {
+			place(cell-1, x|1<<cell, o, key+geometry.powers[cell], xLeft-1, oLeft)
+		}
+		if oLeft > 0 {
+			place(cell-1, x, o|1<<cell, key+2*geometry.powers[cell], xLeft, oLeft-1)
+		}
+	}
+	place(geometry.cells-1, 0, 0, 0, xCount, oCount)
+}
+
+func lookupIn(records []Record, key uint64) (Value, bool) {
+	index := sort.Search(len(records), func(index int) bool {
+		return records[index].Key >= key
+	})
+	if index < len(records) && records[index].Key == key {
+		return records[index].Value, true
+	}
+	return 0, false
+}
+
+// Build solves every position with at least minPieces stones by working
+// backwards from the full board one layer at a time; positions in a layer
+// depend only on the layer with one more stone. X always moves first, so the
+// side to move is X when the piece counts are equal.
+func Build(size int, minPieces int, progress func(pieces int, positions int)) (*Table, error) {
+	geometry, err := NewGeometry(size)
+	if err != nil {
+		return nil, err
+	}
+
+	if minPieces < 0 || minPieces > geometry.cells {
+		return nil, ErrBadMinPieces
+	}
+
+	var layers [][]Record
+	var next []Record
+	for pieces := geometry.cells; pieces >= minPieces; pieces-- {
+		layer := geometry.solveLayer(pieces, next)
+		if progress != nil {
+			progress(pieces, len(layer))
+		}
+		layers = append(layers, layer)
+		next = layer
+	}
+
+	table := &Table{Size: size, MinPieces: minPieces}
+	for _, layer := range layers {
+		table.records = append(table.records, layer...)
+	}
+	slices.SortFunc(table.records, func(a Record, b Record) int {
+		return cmp.Compare(a.Key, b.Key)
+	})
+
+	return table, nil
+}
+
+func (geometry Geometry) solveLayer(pieces int, next []Record) []Record {
+	xCount := (pieces + 1) / 2
+	oCount := pieces / 2
+	xToMove := xCount == oCount
+	full := uint32(1)<<geometry.cells - 1
+
+	var layer []Record
+	geometry.enumerate(xCount, oCount, func(x uint32, o uint32, key uint64) {
+		mover, opponent := o, x
+		if xToMove {
+			mover, opponent = x, o
+		}
+
+		if geometry.hasLine(mover) {
+			return
+		}
+
+		if geometry.hasLine(opponent) {
+			layer = append(layer, Record{Key: key, Value: NewValue(Loss, 0)})
+			return
+		}
+
+		if x|o == full {
+			layer = append(layer, Record{Key: key, Value: NewValue(Draw, 0)})
+			return
+		}
+
+		layer = append(layer, Record{Key: key, Value: geometry.solvePosition(x|o, key, xToMove, next)})
+	})
+
+	return layer
+}
+
+func (geometry Geometry) childKey(key uint64, cell int, xToMove bool) uint64 {
+	if xToMove {
+		return key + geometry.powers[cell]
+	}
+	return key + 2*geometry.powers[cell]
+}
+
+func (geometry Geometry) solvePosition(occupied uint32, key uint64, xToMove bool, next []Record) Value {
+	best := NewValue(Unknown, 0)
+
+	for cell := range geometry.cells {
+		if occupied&(1<<cell) != 0 {
+			continue
+		}
+
+		child, _ := lookupIn(next, geometry.childKey(key, cell, xToMove))
+		if better(reply(child), best) {
+			best = reply(child)
+		}
+	}
+
+	return best
+}
+
+// reply converts a child's value, seen by the opponent, into the value of
+// the move that reaches it for the side to move.
+func reply(child Value) Value {
+	distance := child.Distance() + 1
+	switch child.Outcome() {
+	case Loss:
+		return NewValue(Win, distance)
+	case Win:
+		return NewValue(Loss, distance)
+	default:
+		return NewValue(Draw, distance)
+	}
+}
+
+func rank(value Value) int {
+	switch value.Outcome() {
+	case Win:
+		return 3*64 - value.Distance()
+	case Draw:
+		return 2 * 64
+	case Loss:
+		return 64 + value.Distance()
+	default:
+		return 0
+	}
+}
+
+func better(candidate Value, current Value) bool {
+	return rank(candidate) > rank(current)
+}
+
+func (table *Table) Len() int {
+	return len(table.records)
+}
+
+func (table *Table) Lookup(key uint64) (Value, bool) {
+	return lookupIn(table.records, key)
+}
+
+func (table *Table) Geometry() Geometry {
+	geometry, _ := NewGeometry(table.Size)
+	return geometry
+}
+
+func (table *Table) Covers(x uint32, o uint32) bool {
+	return bits.OnesCount32(x|o) >= table.MinPieces
+}
+
+// BestMove returns the cell index of the best move for the side to move and
+// the value of the position; ok is false when the position or one of its
+// children is not in the table.
+func (table *Table) BestMove(x uint32, o uint32) (cell int, value Value, ok bool) {
+	geometry := table.Geometry()
+	xToMove := bits.OnesCount32(x) == bits.OnesCount32(o)
+	key := geometry.Key(x, o)
+
+	if _, found := table.Lookup(key); !found {
+		return 0, 0, false
+	}
+
+	cell = -1
+	best := NewValue(Unknown, 0)
+	for candidate := range geometry.cells {
+		if (x|o)&(1<<candidate) != 0 {
+			continue
+		}
+
+		child, found := table.Lookup(geometry.childKey(key, candidate, xToMove))
+		if !found {
+			return 0, 0, false
+		}
+
+		if cell < 0 || better(reply(child), best) {
+			cell = candidate
+			best = reply(child)
+		}
+	}
+
+	return cell, best, cell >= 0
+}
+
+// Search is BestMove for positions the table does not cover: it searches
+// forward until every line reaches a position with MinPieces stones and
+// takes values from the table there. The work grows with each missing
+// stone, so it is meant for positions close to the table.
+func (table *Table) Search(x uint32, o uint32) (cell int, value Value, ok bool) {
+	if table.Covers(x, o) {
+		return table.BestMove(x, o)
+	}
+	return table.Geometry().search(table, x, o)
+}
+
+func (geometry Geometry) search(table *Table, x uint32, o uint32) (cell int, value Value, ok bool) {
+	if geometry.hasLine(x) || geometry.hasLine(o) {
+		return 0, 0, false
+	}
+
+	xToMove := bits.OnesCount32(x) == bits.OnesCount32(o)
+	cell = -1
+	best := NewValue(Unknown, 0)
+	for candidate := range geometry.cells {
+		if (x|o)&(1<<candidate) != 0 {
+			continue
+		}
+
+		childX, childO := x, o
+		if xToMove {
+			childX |= 1 << candidate
+		} else {
+			childO |= 1 << candidate
+		}
+
+		child, found := geometry.value(table, childX, childO)
+		if !found {
+			return 0, 0, false
+		}
+
+		if cell < 0 || better(reply(child), best) {
+			cell = candidate
+			best = reply(child)
+		}
+	}
+
+	return cell, best, cell >= 0
+}
+
+// value is the value of a position reached during a search, for the side
+// to move.
+func (geometry Geometry) value(table *Table, x uint32, o uint32) (Value, bool) {
+	if table.Covers(x, o) {
+		return table.Lookup(geometry.Key(x, o))
+	}
+
+	if geometry.hasLine(x) || geometry.hasLine(o) {
+		return NewValue(Loss, 0), true
+	}
+
+	_, value, ok := geometry.search(table, x, o)
+	return value, ok
+}
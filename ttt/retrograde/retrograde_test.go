@@ -0,0 +1,210 @@
+package retrograde
+
+import (
+	"bytes"
+	"errors"
+	"math/bits"
+	"testing"
+	"ttt/boards"
+	"ttt/tablebase"
+)
+
+func TestBuild_CountsReachable3x3Positions(t *testing.T) {
+	table, err := Build(3, 0, nil)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if table.Len() != 5478 {
+		t.Errorf("3x3 should have 5478 legal positions, got %d", table.Len())
+	}
+}
+
+func TestBuild_EmptyBoardIsDrawn(t *testing.T) {
+	table, _ := Build(3, 0, nil)
+
+	value, found := table.Lookup(0)
+
+	if !found {
+		t.Fatal("empty board should be in the table")
+	}
+
+	if value.Outcome() != Draw || value.Distance() != 9 {
+		t.Errorf("empty board should be a draw in 9 plies, got %v in %d", value.Outcome(), value.Distance())
+	}
+}
+
+func TestBuild_AgreesWithTablebase(t *testing.T) {
+	table, _ := Build(3, 0, nil)
+	solved, err := tablebase.Embedded()
+	if err != nil {
+		t.Fatalf("tablebase should load: %v", err)
+	}
+
+	for index := boards.Index(0); index < boards.IndexCount; index++ {
+		board, _ := boards.FromIndex(index)
+		mover, ok := tablebase.SideToMove(board)
+		if !ok {
+			continue
+		}
+
+		score, _, err := solved.Lookup(board, mover)
+		if err != nil {
+			continue
+		}
+
+		value, found := table.Lookup(uint64(index))
+		if !found {
+			t.Fatalf("position %d solved by the tablebase is missing", index)
+		}
+
+		expected := Draw
+		if score > 0 {
+			expected = Win
+		} else if score < 0 {
+			expected = Loss
+		}
+
+		if value.Outcome() != expected {
+			t.Errorf("position %d: got %v, tablebase score %d", index, value.Outcome(), score)
+		}
+	}
+}
+
+func TestBuild_RespectsMinPieces(t *testing.T) {
+	table, _ := Build(3, 5, nil)
+
+	if _, found := table.Lookup(0); found {
+		t.Error("empty board should not be stored when min pieces is 5")
+	}
+
+	if !table.Covers(0b000_000_111, 0b000_011_000) {
+		t.Error("table should cover a five-stone position")
+	}
+}
+
+func TestBuild_Solves4x4Endgames(t *testing.T) {
+	table, err := Build(4, 14, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	geometry := table.Geometry()
+	checked := 0
+	for _, record := range table.records {
+		x, o := geometry.Decode(record.Key)
+		if bits.OnesCount32(x|o) != 14 || record.Value.Distance() == 0 {
+			continue
+		}
+
+		_, value, ok := table.BestMove(x, o)
+		if !ok {
+			t.Fatalf("14-stone position %d should be solved", record.Key)
+		}
+
+		if value != record.Value {
+			t.Fatalf("best move value %v should equal stored value %v", value, record.Value)
+		}
+		checked++
+	}
+
+	if checked == 0 {
+		t.Error("should have non-terminal 14-stone positions")
+	}
+}
+
+func TestBuild_RejectsBadArguments(t *testing.T) {
+	if _, err := Build(2, 0, nil); !errors.Is(err, ErrBadSize) {
+		t.Errorf("should reject size 2, got %v", err)
+	}
+
+	if _, err := Build(3, 10, nil); !errors.Is(err, ErrBadMinPieces) {
+		t.Errorf("should reject min pieces above 9, got %v", err)
+	}
+}
+
+func TestBestMove_TakesWin(t *testing.T) {
+	table, _ := Build(3, 0, nil)
+	x := uint32(0b000_000_011)
+	o := uint32(0b000_011_000)
+
+	cell, value, ok := table.BestMove(x, o)
+
+	if !ok {
+		t.Fatal("position should be solved")
+	}
+
+	if cell != 2 || value.Outcome() != Win || value.Distance() != 1 {
+		t.Errorf("should win immediately at cell 2, got cell %d %v in %d", cell, value.Outcome(), value.Distance())
+	}
+}
+
+func TestSearch_AgreesWithFullTable(t *testing.T) {
+	full, _ := Build(3, 0, nil)
+	endgames, _ := Build(3, 5, nil)
+	geometry := full.Geometry()
+
+	for _, record := range full.records {
+		x, o := geometry.Decode(record.Key)
+		if endgames.Covers(x, o) || record.Value.Distance() == 0 {
+			continue
+		}
+
+		_, value, ok := endgames.Search(x, o)
+		if !ok || value != record.Value {
+			t.Fatalf("position %d: search gave %v (ok %v), full table has %v", record.Key, value, ok, record.Value)
+		}
+	}
+}
+
+func TestGeometry_KeyRoundTrip(t *testing.T) {
+	geometry, _ := NewGeometry(4)
+	x, o := uint32(0b1000_0000_0000_0001), uint32(0b0000_0110_0000_0000)
+
+	decodedX, decodedO := geometry.Decode(geometry.Key(x, o))
+
+	if decodedX != x || decodedO != o {
+		t.Errorf("decode mismatch: %b %b", decodedX, decodedO)
+	}
+}
+
+func TestFormat_RoundTrip(t *testing.T) {
+	table, _ := Build(3, 4, nil)
+	var buffer bytes.Buffer
+
+	if _, err := table.WriteTo(&buffer); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if buffer.Len() != headerBytes+table.Len()*recordBytes {
+		t.Errorf("unexpected file size %d", buffer.Len())
+	}
+
+	decoded, err := Read(&buffer)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if decoded.Size != 3 || decoded.MinPieces != 4 || decoded.Len() != table.Len() {
+		t.Errorf("header mismatch: %+v", decoded)
+	}
+
+	for _, record := range table.records {
+		if value, _ := decoded.Lookup(record.Key); value != record.Value {
+			t.Fatalf("record %d mismatch", record.Key)
+		}
+	}
+}
+
+func TestFormat_RejectsBadHeader(t *testing.T) {
+	header := []byte("TTTR\x09\x03\x00\x00\x00\x00\x00\x00\x00\x00\x00")
+	if _, err := Read(bytes.NewReader(header)); !errors.Is(err, ErrBadVersion) {
+		t.Errorf("should reject unknown version, got %v", err)
+	}
+
+	header = []byte("XXXX\x01\x03\x00\x00\x00\x00\x00\x00\x00\x00\x00")
+	if _, err := Read(bytes.NewReader(header)); !errors.Is(err, ErrBadMagic) {
+		t.Errorf("should reject bad magic, got %v", err)
+	}
+}
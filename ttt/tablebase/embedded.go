@@ -0,0 +1,16 @@
+package tablebase
+
+import (
+	"bytes"
+	_ "embed"
+	"sync"
+)
+
+//go:generate go run ../cmd/tablebase -out ttt3.tb
+
+//go:embed ttt3.tb
+var embeddedTable []byte
+
+var Embedded = sync.OnceValues(func() (*Table, error) {
+	return Read(bytes.NewReader(embeddedTable))
+})
@@ -0,0 +1,169 @@
+package tablebase
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math/bits"
+	"ttt/boards"
+)
+
+// File layout (all integers big-endian):
+//
+//	magic    [4]byte  "TTTB"
+//	version  uint8    1
+//	count    uint16   number of entries, always boards.IndexCount
+//	entries  count × { value int8, bestMoves uint16 }
+//
+// Entries are stored in boards.Index order. Positions are solved with X
+// moving first, so the side to move follows from the piece counts; value is
+// the minimax score for the side to move and bit p-1 of bestMoves is set when
+// position p is an optimal reply. Terminal and unreachable positions store
+// NoValue.
+const (
+	Magic      = "TTTB"
+	Version    = 1
+	NoValue    = int8(-128)
+	entryBytes = 3
+)
+
+var (
+	ErrBadMagic    = errors.New("tablebase: bad magic")
+	ErrBadVersion  = errors.New("tablebase: unsupported version")
+	ErrNotInTable  = errors.New("tablebase: position not in table")
+	ErrWrongLength = errors.New("tablebase: unexpected entry count")
+)
+
+type entry struct {
+	value     int8
+	bestMoves uint16
+}
+
+type Table struct {
+	entries [boards.IndexCount]entry
+}
+
+type Solver func(board boards.Board, mover boards.Cell) (value int8, bestMoves []int)
+
+func SideToMove(board boards.Board) (boards.Cell, bool) {
+	xCount, oCount := pieceCounts(board)
+
+	switch xCount - oCount {
+	case 0:
+		return boards.PlayerX, true
+	case 1:
+		return boards.PlayerO, true
+	default:
+		return boards.Empty, false
+	}
+}
+
+func pieceCounts(board boards.Board) (int, int) {
+	bitboard := boards.FromBoard(board)
+	return bits.OnesCount16(bitboard.X), bits.OnesCount16(bitboard.O)
+}
+
+func Build(solve Solver) *Table {
+	table := &Table{}
+
+	for index := boards.Index(0); index < boards.IndexCount; index++ {
+		table.entries[index] = entry{value: NoValue}
+
+		board, _ := boards.FromIndex(index)
+		mover, ok := SideToMove(board)
+		if !ok || board.GetGameStatus() != boards.InProgress {
+			continue
+		}
+
+		value, bestMoves := solve(board, mover)
+		table.entries[index] = entry{value: value, bestMoves: moveMask(bestMoves)}
+	}
+
+	return table
+}
+
+func moveMask(moves []int) uint16 {
+	var mask uint16
+	for _, move := range moves {
+		mask |= 1 << (move - boards.MinPosition)
+	}
+	return mask
+}
+
+func movesFromMask(mask uint16) []int {
+	var moves []int
+	for ; mask != 0; mask &= mask - 1 {
+		moves = append(moves, bits.TrailingZeros16(mask)+boards.MinPosition)
+	}
+	return moves
+}
+
+// Lookup answers for mover even when the game started with O: a position
+// where O moved first is the colour-swapped image of one where X did.
+func (table *Table) Lookup(board boards.Board, mover boards.Cell) (int8, []int, error) {
+	if impliedMover, ok := SideToMove(board); !ok || impliedMover != mover {
+		board = board.SwapColors()
+	}
+
+	found := table.entries[board.Index()]
+	if found.value == NoValue {
+		return 0, nil, ErrNotInTable
+	}
+
+	return found.value, movesFromMask(found.bestMoves), nil
+}
+
+func (table *Table) WriteTo(writer io.Writer) (int64, error) {
+	buffered := bufio.NewWriter(writer)
+	data := []byte(Magic)
+	data = append(data, Version)
+	data = binary.BigEndian.AppendUint16(data, boards.IndexCount)
+
+	for _, stored := range table.entries {
+		data = append(data, byte(stored.value))
+		data = binary.BigEndian.AppendUint16(data, stored.bestMoves)
+	}
+
+	written, err := buffered.Write(data)
+	if err != nil {
+		return int64(written), err
+	}
+	return int64(written), buffered.Flush()
+}
+
+func Read(reader io.Reader) (*Table, error) {
+	header := make([]byte, len(Magic)+3)
+	if _, err := io.ReadFull(reader, header); err != nil {
+		return nil, fmt.Errorf("tablebase: reading header: %w", err)
+	}
+
+	if string(header[:len(Magic)]) != Magic {
+		return nil, ErrBadMagic
+	}
+
+	if header[len(Magic)] != Version {
+		return nil, ErrBadVersion
+	}
+
+	if binary.BigEndian.Uint16(header[len(Magic)+1:]) != boards.IndexCount {
+		return nil, ErrWrongLength
+	}
+
+	data := make([]byte, boards.IndexCount*entryBytes)
+	if _, err := io.ReadFull(reader, data); err != nil {
+		return nil, fmt.Errorf("tablebase: reading entries: %w", err)
+	}
+
+	table := &Table{}
+	for index := range table.entries {
+		offset := index * entryBytes
+		table.entries[index] = entry{
+			value:     int8(data[offset]),
+			bestMoves: binary.BigEndian.Uint16(data[offset+1:]),
+		}
+	}
+
+	return table, nil
+}
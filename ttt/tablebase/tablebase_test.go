@@ -0,0 +1,123 @@
+package tablebase
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"ttt/boards"
+)
+
+func firstMoveSolver(board boards.Board, mover boards.Cell) (int8, []int) {
+	if mover == boards.PlayerX {
+		return 1, board.AvailableMoves()[:1]
+	}
+	return -1, board.AvailableMoves()[:1]
+}
+
+func TestSideToMove_FollowsPieceCounts(t *testing.T) {
+	board := boards.NewBoard()
+	if mover, ok := SideToMove(board); !ok || mover != boards.PlayerX {
+		t.Errorf("X should move on an empty board, got %v", mover)
+	}
+
+	board.MakeMove(5, boards.PlayerX)
+	if mover, ok := SideToMove(board); !ok || mover != boards.PlayerO {
+		t.Errorf("O should move after X, got %v", mover)
+	}
+
+	board.MakeMove(1, boards.PlayerX)
+	if _, ok := SideToMove(board); ok {
+		t.Error("two extra X pieces should be unreachable")
+	}
+}
+
+func TestBuild_SkipsTerminalAndUnreachablePositions(t *testing.T) {
+	table := Build(firstMoveSolver)
+	won := boards.Board{
+		{boards.PlayerX, boards.PlayerX, boards.PlayerX},
+		{boards.PlayerO, boards.PlayerO, boards.Empty},
+		{boards.Empty, boards.Empty, boards.Empty},
+	}
+
+	if _, _, err := table.Lookup(won, boards.PlayerO); !errors.Is(err, ErrNotInTable) {
+		t.Errorf("terminal position should not be in the table, got %v", err)
+	}
+}
+
+func TestLookup_ReturnsSolvedMoves(t *testing.T) {
+	table := Build(firstMoveSolver)
+
+	value, moves, err := table.Lookup(boards.NewBoard(), boards.PlayerX)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if value != 1 || len(moves) != 1 || moves[0] != 1 {
+		t.Errorf("got value %d moves %v", value, moves)
+	}
+}
+
+func TestLookup_SwapsColorsWhenOMovedFirst(t *testing.T) {
+	table := Build(firstMoveSolver)
+	board := boards.NewBoard()
+	board.MakeMove(5, boards.PlayerO)
+
+	value, _, err := table.Lookup(board, boards.PlayerX)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if value != -1 {
+		t.Errorf("X replying to O should read the swapped O-to-move entry, got %d", value)
+	}
+}
+
+func TestReadWrite_RoundTrip(t *testing.T) {
+	table := Build(firstMoveSolver)
+	var buffer bytes.Buffer
+
+	if _, err := table.WriteTo(&buffer); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if buffer.Len() != len(Magic)+3+boards.IndexCount*entryBytes {
+		t.Errorf("unexpected file size %d", buffer.Len())
+	}
+
+	decoded, err := Read(&buffer)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if decoded.entries != table.entries {
+		t.Error("decoded table should match the original")
+	}
+}
+
+func TestRead_RejectsBadHeader(t *testing.T) {
+	if _, err := Read(bytes.NewReader([]byte("NOPE\x01\x4c\xe3"))); !errors.Is(err, ErrBadMagic) {
+		t.Errorf("should reject bad magic, got %v", err)
+	}
+
+	if _, err := Read(bytes.NewReader([]byte("TTTB\x09\x4c\xe3"))); !errors.Is(err, ErrBadVersion) {
+		t.Errorf("should reject unknown version, got %v", err)
+	}
+
+	if _, err := Read(bytes.NewReader([]byte("TTTB\x01\x4c\xe3"))); err == nil {
+		t.Error("should reject truncated entries")
+	}
+}
+
+func TestEmbedded_Loads(t *testing.T) {
+	table, err := Embedded()
+
+	if err != nil {
+		t.Fatalf("embedded table should load: %v", err)
+	}
+
+	if _, moves, err := table.Lookup(boards.NewBoard(), boards.PlayerX); err != nil || len(moves) == 0 {
+		t.Errorf("embedded table should solve the empty board, got %v %v", moves, err)
+	}
+}
@@ -0,0 +1,86 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io/fs"
+	"math/rand"
+	"os"
+	"time"
+	"ttt/game"
+	"ttt/learning"
+)
+
+func loadTable(path string) (*learning.Table, error) {
+	file, err := os.Open(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return learning.NewTable(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return learning.Read(file)
+}
+
+func saveTable(path string, table *learning.Table) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	if _, err := table.WriteTo(file); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+func runTrain(args []string) {
+	flags := flag.NewFlagSet("train", flag.ExitOnError)
+	games := flags.Int("games", 10000, "number of training games")
+	batch := flags.Int("batch", 1000, "games per line of the learning curve")
+	opponent := flags.String("opponent", "ai", "training opponent: ai or self")
+	tablePath := flags.String("table", "menace.ttl", "learned table to continue from and save to")
+	seed := flags.Int64("seed", 0, "random seed; 0 seeds from the clock")
+	flags.Parse(args)
+
+	if *seed == 0 {
+		*seed = time.Now().UnixNano()
+	}
+	random := rand.New(rand.NewSource(*seed))
+
+	table, err := loadTable(*tablePath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Loading %s: %v\n", *tablePath, err)
+		os.Exit(1)
+	}
+
+	var rival game.Opponent
+	switch *opponent {
+	case "ai":
+		rival = game.AIOpponent(random)
+	case "self":
+		rival = game.SelfPlayOpponent(table, random)
+	default:
+		fmt.Fprintln(os.Stderr, "Opponent must be ai or self")
+		os.Exit(2)
+	}
+
+	game.Train(table, rival, game.TrainingOptions{
+		Games:     *games,
+		BatchSize: *batch,
+		Random:    random,
+		Report: func(result game.BatchResult) {
+			fmt.Printf("batch %d: %d wins, %d draws, %d losses\n", result.Batch, result.Wins, result.Draws, result.Losses)
+		},
+	})
+
+	if err := saveTable(*tablePath, table); err != nil {
+		fmt.Fprintf(os.Stderr, "Saving %s: %v\n", *tablePath, err)
+		os.Exit(1)
+	}
+	fmt.Printf("Saved %d positions to %s\n", table.Len(), *tablePath)
+}
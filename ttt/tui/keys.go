@@ -0,0 +1,82 @@
+package tui
+
+import (
+	"bufio"
+)
+
+type Key int
+
+const (
+	KeyNone Key = iota
+	KeyUp
+	KeyDown
+	KeyLeft
+	KeyRight
+	KeyEnter
+	KeyQuit
+)
+
+const (
+	keyEscape = 0x1b
+	keyCtrlC  = 0x03
+)
+
+// ReadKey decodes one key press. Arrow keys arrive as ESC [ A-D (or ESC O A-D
+// in application cursor mode); a lone ESC is treated as quit.
+func ReadKey(reader *bufio.Reader) (Key, error) {
+	input, err := reader.ReadByte()
+	if err != nil {
+		return KeyNone, err
+	}
+
+	switch input {
+	case keyEscape:
+		return readEscape(reader)
+	case keyCtrlC, 'q', 'Q':
+		return KeyQuit, nil
+	case '\r', '\n', ' ':
+		return KeyEnter, nil
+	case 'w', 'W', 'k':
+		return KeyUp, nil
+	case 's', 'S', 'j':
+		return KeyDown, nil
+	case 'a', 'A', 'h':
+		return KeyLeft, nil
+	case 'd', 'D', 'l':
+		return KeyRight, nil
+	default:
+		return KeyNone, nil
+	}
+}
+
+func readEscape(reader *bufio.Reader) (Key, error) {
+	if reader.Buffered() == 0 {
+		return KeyQuit, nil
+	}
+
+	introducer, err := reader.ReadByte()
+	if err != nil {
+		return KeyNone, err
+	}
+	if introducer != '[' && introducer != 'O' {
+		return KeyNone, nil
+	}
+
+	final, err := reader.ReadByte()
+	if err != nil {
+		return KeyNone, err
+	}
+
+	switch final {
+	case 'A':
+		return KeyUp, nil
+	case 'B':
+		return KeyDown, nil
+	case 'C':
+		return KeyRight, nil
+	case 'D':
+		return KeyLeft, nil
+	default:
+		return KeyNone, nil
+	}
+}
@@ -0,0 +1,75 @@
+package tui
+
+import (
+	"context"
+	"ttt/boards"
+)
+
+const (
+	centerPosition = 5
+	positionTaken  = "Position already taken, try again"
+)
+
+// Player is a human who picks moves by walking the cursor over the board.
+type Player struct {
+	ui *UI
+}
+
+func (ui *UI) NewPlayer() *Player {
+	return &Player{ui: ui}
+}
+
+func (player *Player) ReadMove(board boards.Board) (int, error) {
+	return player.ReadMoveContext(context.Background(), board)
+}
+
+// ReadMoveContext gives up when ctx ends, such as when the clock runs out.
+// Only the key read is left behind; the UI takes its key next.
+func (player *Player) ReadMoveContext(ctx context.Context, board boards.Board) (int, error) {
+	ui := player.ui
+	ui.choosing = true
+	defer func() {
+		ui.choosing = false
+	}()
+
+	for {
+		ui.redraw()
+
+		key, err := ui.readKey(ctx)
+		if err != nil {
+			return 0, err
+		}
+
+		switch key {
+		case KeyUp, KeyDown, KeyLeft, KeyRight:
+			ui.cursor = moveCursor(ui.cursor, key)
+		case KeyEnter:
+			if board.IsPositionValid(ui.cursor) {
+				ui.message = ""
+				return ui.cursor, nil
+			}
+			ui.message = positionTaken
+		case KeyQuit:
+			return 0, ErrQuit
+		}
+	}
+}
+
+// moveCursor steps one cell in the direction of key, stopping at the edges.
+func moveCursor(position int, key Key) int {
+	row := (position - boards.MinPosition) / boards.BoardSize
+	col := (position - boards.MinPosition) % boards.BoardSize
+
+	switch key {
+	case KeyUp:
+		row = max(row-1, 0)
+	case KeyDown:
+		row = min(row+1, boards.BoardSize-1)
+	case KeyLeft:
+		col = max(col-1, 0)
+	case KeyRight:
+		col = min(col+1, boards.BoardSize-1)
+	}
+
+	return boards.PositionOf(row, col)
+}
@@ -0,0 +1,68 @@
+package tui
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"ttt/boards"
+	tttio "ttt/io"
+)
+
+const (
+	enterAltScreen = "\x1b[?1049h"
+	leaveAltScreen = "\x1b[?1049l"
+	hideCursor     = "\x1b[?25l"
+	showCursor     = "\x1b[?25h"
+	clearScreen    = "\x1b[H\x1b[2J"
+	rawNewline     = "\r\n"
+	menuMarker     = "> "
+	menuPadding    = "  "
+	boardHelp      = "Arrows/WASD move, Enter places, q quits"
+	menuHelp       = "Up/Down choose, Enter selects, q quits"
+	playAgainHelp  = "Press Enter to play again or q to quit"
+)
+
+type Score struct {
+	X     int
+	O     int
+	Draws int
+}
+
+func (score *Score) Record(status boards.GameStatus) {
+	switch status {
+	case boards.XWins:
+		score.X++
+	case boards.OWins:
+		score.O++
+	case boards.Draw:
+		score.Draws++
+	}
+}
+
+// screen redraws the whole frame on every change. The terminal is in raw
+// mode, so output post-processing is off and every line ends in CR LF.
+type screen struct {
+	output io.Writer
+}
+
+func (screen screen) draw(lines ...string) {
+	frame := strings.Join(lines, "\n")
+	fmt.Fprint(screen.output, clearScreen+strings.ReplaceAll(frame, "\n", rawNewline))
+}
+
+func FormatScore(roster tttio.Roster, score Score) string {
+	return fmt.Sprintf("Score: %s %d, %s %d, draws %d",
+		roster.X.Name, score.X, roster.O.Name, score.O, score.Draws)
+}
+
+func formatMenu(title string, choices []string, selected int) []string {
+	lines := []string{title, ""}
+	for index, choice := range choices {
+		prefix := menuPadding
+		if index == selected {
+			prefix = menuMarker
+		}
+		lines = append(lines, prefix+choice)
+	}
+	return append(lines, "", menuHelp)
+}
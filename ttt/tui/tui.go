@@ -0,0 +1,339 @@
+package tui
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"os"
+	"ttt/boards"
+	"ttt/game"
+	tttio "ttt/io"
+	"ttt/players"
+)
+
+const title = "Tic-Tac-Toe"
+
+var (
+	ErrNotTerminal = errors.New("tui: input is not a terminal")
+	ErrQuit        = errors.New("player quit")
+)
+
+var firstPlayerChoices = []string{"Player X", "Player O", "Random", "Alternate each game"}
+
+type Options struct {
+	Roster      tttio.Roster
+	Random      *rand.Rand
+	TimeControl game.TimeControl
+	PlayerX     string // registered kind names; empty asks each game
+	PlayerO     string
+	KindOptions map[string]map[string]string
+	Color       tttio.ColorMode
+}
+
+// UI is a full-screen front end. It implements game.GameObserver to redraw
+// the board and status lines as the game progresses.
+type UI struct {
+	input    *bufio.Reader
+	pending  chan keyResult
+	screen   screen
+	roster   tttio.Roster
+	random   *rand.Rand
+	control  game.TimeControl
+	kinds    map[boards.Cell]string
+	options  map[string]map[string]string
+	clock    *game.Clock
+	renderer tttio.Renderer
+	score    Score
+	board    boards.Board
+	lastMove int
+	lines    []boards.Line
+	cursor   int
+	choosing bool
+	status   string
+	message  string
+}
+
+func New(input *bufio.Reader, output io.Writer, options Options) *UI {
+	var renderer tttio.Renderer = tttio.MonochromeRenderer{}
+	if tttio.UseColor(options.Color, output) {
+		renderer = tttio.ANSIRenderer{}
+	}
+
+	return &UI{
+		input:    input,
+		screen:   screen{output: output},
+		roster:   options.Roster,
+		random:   options.Random,
+		control:  options.TimeControl,
+		kinds:    map[boards.Cell]string{boards.PlayerX: options.PlayerX, boards.PlayerO: options.PlayerO},
+		options:  options.KindOptions,
+		renderer: renderer,
+		cursor:   centerPosition,
+	}
+}
+
+// Run switches the terminal to raw mode and the alternate screen, plays a
+// session and restores the terminal before returning the final score. The
+// terminal is restored even if a player panics; the panic then continues.
+func Run(input *os.File, output io.Writer, options Options) (_ Score, err error) {
+	state, err := makeRaw(input.Fd())
+	if err != nil {
+		return Score{}, fmt.Errorf("%w: %w", ErrNotTerminal, err)
+	}
+
+	fmt.Fprint(output, enterAltScreen+hideCursor)
+	defer func() {
+		fmt.Fprint(output, showCursor+leaveAltScreen)
+		if restoreErr := restore(input.Fd(), state); err == nil {
+			err = restoreErr
+		}
+	}()
+
+	ui := New(bufio.NewReader(input), output, options)
+	err = ui.PlaySession()
+	return ui.score, err
+}
+
+func IsTerminal(file *os.File) bool {
+	return isTerminal(file.Fd())
+}
+
+func (ui *UI) Score() Score {
+	return ui.score
+}
+
+func (ui *UI) PlaySession() error {
+	firstPlayer, err := ui.choose("Who moves first?", firstPlayerChoices)
+	if err != nil {
+		return quitIsNil(err)
+	}
+	firstPlayerChoice := tttio.FirstPlayerChoice(firstPlayer)
+
+	for gameNumber := 0; ; gameNumber++ {
+		playerX, err := ui.choosePlayer(boards.PlayerX)
+		if err != nil {
+			return quitIsNil(err)
+		}
+
+		playerO, err := ui.choosePlayer(boards.PlayerO)
+		if err != nil {
+			return quitIsNil(err)
+		}
+
+		current := game.NewGame(playerX, playerO, io.Discard,
+			game.WithFirstPlayer(game.FirstPlayerFor(firstPlayerChoice, gameNumber, ui.random)),
+			game.WithRoster(ui.roster),
+			game.WithTimeControl(ui.control))
+		ui.clock = current.Clock()
+		current.AddObserver(ui)
+
+		if _, err := current.PlayGame(); err != nil {
+			return quitIsNil(err)
+		}
+
+		if err := ui.waitForPlayAgain(); err != nil {
+			return quitIsNil(err)
+		}
+	}
+}
+
+func quitIsNil(err error) error {
+	if errors.Is(err, ErrQuit) {
+		return nil
+	}
+	return err
+}
+
+// choosePlayer offers every registered kind unless one was preset. Humans
+// move with the cursor here, so the human kind is built by the UI rather
+// than the registry.
+func (ui *UI) choosePlayer(symbol boards.Cell) (players.Player, error) {
+	kinds := players.Kinds()
+	choices := make([]string, len(kinds))
+	for index, item := range players.MenuItems() {
+		choices[index] = tttio.FormatMenuItem(item)
+	}
+
+	name := ui.kinds[symbol]
+	prompt := fmt.Sprintf("Select %s type:", ui.roster.For(symbol).Name)
+	for {
+		if name == "" {
+			choice, err := ui.choose(prompt, choices)
+			if err != nil {
+				return nil, err
+			}
+			name = kinds[choice].Name
+		}
+
+		if name == players.HumanKind {
+			return ui.NewPlayer(), nil
+		}
+
+		player, err := players.CreatePlayer(name, players.Seat{
+			Symbol:  symbol,
+			Random:  ui.random,
+			Options: ui.options[name],
+		})
+		if err == nil {
+			return player, nil
+		}
+		prompt = fmt.Sprintf("%v. Select %s type:", err, ui.roster.For(symbol).Name)
+		name = ""
+	}
+}
+
+func (ui *UI) choose(prompt string, choices []string) (int, error) {
+	selected := 0
+	for {
+		lines := append([]string{title, ""}, formatMenu(prompt, choices, selected)...)
+		ui.screen.draw(append(lines, "", FormatScore(ui.roster, ui.score))...)
+
+		key, err := ui.readKey(context.Background())
+		if err != nil {
+			return 0, err
+		}
+
+		switch key {
+		case KeyUp:
+			selected = max(selected-1, 0)
+		case KeyDown:
+			selected = min(selected+1, len(choices)-1)
+		case KeyEnter:
+			return selected, nil
+		case KeyQuit:
+			return 0, ErrQuit
+		}
+	}
+}
+
+func (ui *UI) waitForPlayAgain() error {
+	ui.message = playAgainHelp
+	ui.redraw()
+
+	for {
+		key, err := ui.readKey(context.Background())
+		if err != nil {
+			return err
+		}
+
+		switch key {
+		case KeyEnter:
+			ui.message = ""
+			return nil
+		case KeyQuit:
+			return ErrQuit
+		}
+	}
+}
+
+type keyResult struct {
+	key Key
+	err error
+}
+
+// readKey reads the next key, giving up when ctx ends. A read left blocked
+// stays pending and its key goes to the next call instead of being lost,
+// so only one goroutine ever reads the input.
+func (ui *UI) readKey(ctx context.Context) (Key, error) {
+	if ui.pending == nil && ctx.Done() == nil {
+		return ReadKey(ui.input)
+	}
+
+	if ui.pending == nil {
+		pending := make(chan keyResult, 1)
+		ui.pending = pending
+		go func() {
+			key, err := ReadKey(ui.input)
+			pending <- keyResult{key: key, err: err}
+		}()
+	}
+
+	select {
+	case result := <-ui.pending:
+		ui.pending = nil
+		return result.key, result.err
+	case <-ctx.Done():
+		return 0, ctx.Err()
+	}
+}
+
+func (ui *UI) redraw() {
+	view := tttio.BoardView{
+		Board:    ui.board,
+		Roster:   ui.roster,
+		LastMove: ui.lastMove,
+		Lines:    ui.lines,
+	}
+	if ui.choosing {
+		view.Cursor = ui.cursor
+	}
+
+	ui.screen.draw(
+		title,
+		"",
+		ui.renderer.RenderBoard(view),
+		"",
+		ui.status,
+		FormatScore(ui.roster, ui.score),
+		ui.message,
+		"",
+		boardHelp)
+}
+
+func (ui *UI) OnGameStart(board boards.Board) {
+	ui.board = board
+	ui.lastMove = 0
+	ui.lines = nil
+	ui.status = ""
+	ui.message = ""
+	ui.redraw()
+}
+
+func (ui *UI) OnTurnStart(player boards.Cell, board boards.Board) {
+	identity := ui.roster.For(player)
+	ui.status = fmt.Sprintf("%s's turn (%s)", identity.Name, identity.Token)
+	if ui.clock != nil {
+		ui.status += fmt.Sprintf(", %s left", tttio.FormatClock(ui.clock.Budget(player)))
+	}
+	ui.redraw()
+}
+
+func (ui *UI) OnMove(player boards.Cell, position int, board boards.Board) {
+	ui.board = board
+	ui.lastMove = position
+	_, ui.lines = board.WinningLines()
+	ui.redraw()
+}
+
+func (ui *UI) OnInvalidMove(player boards.Cell, position int, err error) {
+	ui.message = fmt.Sprintf("%s made an illegal move (%d): %v", ui.roster.For(player).Name, position, err)
+	ui.redraw()
+}
+
+func (ui *UI) OnGameEnd(result game.GameResult, board boards.Board) {
+	ui.board = board
+	ui.lines = result.Lines
+	ui.score.Record(result.Status)
+	ui.status = outcome(ui.roster, result)
+	ui.redraw()
+}
+
+func outcome(roster tttio.Roster, result game.GameResult) string {
+	if result.Reason == game.OutOfTime {
+		return roster.For(result.Player).Name + " ran out of time. " + roster.For(result.Player.Opponent()).Name + " wins!"
+	}
+
+	switch result.Status {
+	case boards.XWins:
+		return roster.X.Name + " wins!"
+	case boards.OWins:
+		return roster.O.Name + " wins!"
+	case boards.Draw:
+		return "Draw! Board is full."
+	default:
+		return "Game ended early."
+	}
+}
@@ -0,0 +1,249 @@
+package tui
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"io"
+	"math/rand"
+	"strings"
+	"testing"
+	"time"
+	"ttt/boards"
+	"ttt/game"
+	tttio "ttt/io"
+	"ttt/players"
+)
+
+const (
+	arrowUp    = "\x1b[A"
+	arrowDown  = "\x1b[B"
+	arrowRight = "\x1b[C"
+	arrowLeft  = "\x1b[D"
+	enter      = "\r"
+)
+
+func newTestUI(input string) (*UI, *bytes.Buffer) {
+	var output bytes.Buffer
+	ui := New(bufio.NewReader(strings.NewReader(input)), &output, Options{
+		Roster: tttio.DefaultRoster(),
+		Random: rand.New(rand.NewSource(1)),
+	})
+	return ui, &output
+}
+
+func TestReadKey_DecodesArrowsWASDAndControls(t *testing.T) {
+	input := arrowUp + arrowDown + arrowRight + arrowLeft + "\x1bOA" + "wSaD" + "\r\n q\x03"
+	expected := []Key{
+		KeyUp, KeyDown, KeyRight, KeyLeft, KeyUp,
+		KeyUp, KeyDown, KeyLeft, KeyRight,
+		KeyEnter, KeyEnter, KeyEnter, KeyQuit, KeyQuit,
+	}
+
+	reader := bufio.NewReader(strings.NewReader(input))
+	for index, want := range expected {
+		key, err := ReadKey(reader)
+		if err != nil {
+			t.Fatalf("key %d: unexpected error %v", index, err)
+		}
+		if key != want {
+			t.Errorf("key %d: expected %d, got %d", index, want, key)
+		}
+	}
+
+	if _, err := ReadKey(reader); err != io.EOF {
+		t.Errorf("expected io.EOF after the input, got %v", err)
+	}
+}
+
+func TestReadKey_LoneEscapeQuits(t *testing.T) {
+	key, err := ReadKey(bufio.NewReader(strings.NewReader("\x1b")))
+	if err != nil || key != KeyQuit {
+		t.Errorf("expected KeyQuit, got %d (%v)", key, err)
+	}
+}
+
+func TestMoveCursor_StopsAtEdges(t *testing.T) {
+	tests := []struct {
+		position int
+		key      Key
+		expected int
+	}{
+		{5, KeyUp, 2},
+		{5, KeyDown, 8},
+		{5, KeyLeft, 4},
+		{5, KeyRight, 6},
+		{1, KeyUp, 1},
+		{1, KeyLeft, 1},
+		{9, KeyDown, 9},
+		{9, KeyRight, 9},
+	}
+
+	for _, test := range tests {
+		if got := moveCursor(test.position, test.key); got != test.expected {
+			t.Errorf("moveCursor(%d, %d): expected %d, got %d", test.position, test.key, test.expected, got)
+		}
+	}
+}
+
+func TestPlayer_ReadMove_PlacesAtCursor(t *testing.T) {
+	ui, _ := newTestUI(arrowUp + arrowLeft + enter)
+
+	position, err := ui.NewPlayer().ReadMove(boards.NewBoard())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if position != 1 {
+		t.Errorf("expected position 1, got %d", position)
+	}
+}
+
+func TestPlayer_ReadMove_RejectsTakenCell(t *testing.T) {
+	ui, output := newTestUI(enter + arrowRight + enter)
+	board := boards.NewBoard()
+	board.MakeMove(5, boards.PlayerX)
+
+	position, err := ui.NewPlayer().ReadMove(board)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if position != 6 {
+		t.Errorf("expected position 6, got %d", position)
+	}
+	if !strings.Contains(output.String(), positionTaken) {
+		t.Error("expected a message about the taken position")
+	}
+}
+
+func TestPlayer_ReadMove_Quit(t *testing.T) {
+	ui, _ := newTestUI("q")
+
+	_, err := ui.NewPlayer().ReadMove(boards.NewBoard())
+	if !errors.Is(err, ErrQuit) {
+		t.Errorf("expected ErrQuit, got %v", err)
+	}
+}
+
+func TestScreen_UsesCarriageReturns(t *testing.T) {
+	ui, output := newTestUI("")
+	ui.redraw()
+
+	frame := output.String()
+	if !strings.HasPrefix(frame, clearScreen) {
+		t.Error("each frame should start by clearing the screen")
+	}
+	if strings.Count(frame, "\n") != strings.Count(frame, rawNewline) {
+		t.Error("every newline should be preceded by a carriage return in raw mode")
+	}
+}
+
+func TestNew_ColorFollowsMode(t *testing.T) {
+	for _, test := range []struct {
+		mode  tttio.ColorMode
+		color bool
+	}{
+		{tttio.ColorAlways, true},
+		{tttio.ColorNever, false},
+		{tttio.ColorAuto, false}, // a buffer is not a terminal
+	} {
+		var output bytes.Buffer
+		ui := New(bufio.NewReader(strings.NewReader("")), &output, Options{Roster: tttio.DefaultRoster(), Color: test.mode})
+		ui.choosing = true
+		ui.redraw()
+
+		if colored := strings.Contains(output.String(), "\x1b[2m"); colored != test.color {
+			t.Errorf("mode %d: expected colored cells %v, got %q", test.mode, test.color, output.String())
+		}
+		if !strings.Contains(output.String(), "\x1b[7m5") {
+			t.Errorf("mode %d: the cursor should always be shown", test.mode)
+		}
+	}
+}
+
+func TestPlaySession_HumanGameUpdatesScore(t *testing.T) {
+	// X first, both human; X takes the top row while O plays the middle row.
+	moves := strings.Join([]string{
+		arrowUp + arrowLeft + enter,  // X 1
+		arrowDown + enter,            // O 4
+		arrowUp + arrowRight + enter, // X 2
+		arrowDown + enter,            // O 5
+		arrowUp + arrowRight + enter, // X 3
+	}, "")
+	input := enter + enter + enter + moves + "q"
+
+	ui, output := newTestUI(input)
+	if err := ui.PlaySession(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if ui.Score() != (Score{X: 1}) {
+		t.Errorf("expected X to have one win, got %+v", ui.Score())
+	}
+	if !strings.Contains(output.String(), "Player X wins!") {
+		t.Error("expected the winner in the status line")
+	}
+	if !strings.Contains(output.String(), "Score: Player X 1, Player O 0, draws 0") {
+		t.Error("expected the updated score line")
+	}
+}
+
+func TestPlaySession_QuitFromMenu(t *testing.T) {
+	ui, _ := newTestUI("q")
+
+	if err := ui.PlaySession(); err != nil {
+		t.Errorf("quitting should end the session cleanly, got %v", err)
+	}
+}
+
+func TestPlaySession_AIGamesAndPlayAgain(t *testing.T) {
+	// X first, AI against AI twice, then quit: perfect play always draws.
+	input := enter + arrowDown + enter + arrowDown + enter + enter +
+		arrowDown + enter + arrowDown + enter + "q"
+
+	ui, _ := newTestUI(input)
+	if err := ui.PlaySession(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if ui.Score() != (Score{Draws: 2}) {
+		t.Errorf("expected two draws, got %+v", ui.Score())
+	}
+}
+
+// Run with -race: the key read left behind by the clock must be the one
+// that answers the play again screen.
+func TestPlaySession_HumanTimesOutThenQuits(t *testing.T) {
+	reader, writer := io.Pipe()
+	go func() {
+		writer.Write([]byte(enter))
+		time.Sleep(150 * time.Millisecond)
+		writer.Write([]byte("q"))
+	}()
+
+	ui := New(bufio.NewReader(reader), io.Discard, Options{
+		Roster:      tttio.DefaultRoster(),
+		Random:      rand.New(rand.NewSource(1)),
+		TimeControl: game.TimeControl{PerMove: 50 * time.Millisecond},
+		PlayerX:     players.HumanKind,
+		PlayerO:     players.HumanKind,
+	})
+
+	done := make(chan error, 1)
+	go func() {
+		done <- ui.PlaySession()
+	}()
+
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Errorf("q should end the session cleanly, got %v", err)
+		}
+	case <-time.After(2 * time.Second):
+		writer.Close()
+		t.Fatal("q typed after the timeout should end the session")
+	}
+
+	if ui.Score() != (Score{O: 1}) {
+		t.Errorf("X should lose on time, got %+v", ui.Score())
+	}
+}